@@ -0,0 +1,92 @@
+package when
+
+import (
+	"sort"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/pkg/errors"
+)
+
+// Alternative is another reading of an ambiguous text, see
+// Result.Alternatives
+type Alternative struct {
+	// Time is the time of the reading
+	Time time.Time
+	// Confidence is the likelihood of the reading, from 0 to 1
+	Confidence float64
+	// Labels name the assumptions of the reading, one for each ambiguous
+	// match, like "pm"
+	Labels []string
+}
+
+// reading returns the confidence and the labels of the reading the rules
+// picked
+func reading(c *rules.Context) (float64, []string) {
+	confidence := 1.0
+	var labels []string
+	for _, a := range c.Ambiguities {
+		confidence *= a.Confidence
+		labels = append(labels, a.Label)
+	}
+	return confidence, labels
+}
+
+// settle drops the ambiguities of the context whose alternatives all
+// resolve to the time of the picked reading, like the week after of "next
+// tuesday march 1", they leave nothing to choose from
+func settle(c *rules.Context, base, picked time.Time, strict bool) error {
+	var kept []rules.Ambiguity
+	for _, a := range c.Ambiguities {
+		for _, alt := range a.Alternatives {
+			next := c.Copy()
+			alt.Apply(&next)
+			t, err := bind(&next, base, strict)
+			if err != nil {
+				return errors.Wrap(err, "bind alternative context")
+			}
+			if !t.Equal(picked) {
+				kept = append(kept, a)
+				break
+			}
+		}
+	}
+	c.Ambiguities = kept
+	return nil
+}
+
+// alternatives returns the other readings of the ambiguous matches, the
+// most likely first. Each of them changes the assumption of one match and
+// keeps the others, the ones resolving to the time of the picked reading
+// are left out. They are bound as the picked one is, see bind.
+func alternatives(c *rules.Context, base, picked time.Time, strict bool) ([]Alternative, error) {
+	confidence, labels := reading(c)
+
+	var list []Alternative
+	for i, a := range c.Ambiguities {
+		for _, alt := range a.Alternatives {
+			next := c.Copy()
+			alt.Apply(&next)
+			t, err := bind(&next, base, strict)
+			if err != nil {
+				return nil, errors.Wrap(err, "bind alternative context")
+			}
+			if t.Equal(picked) {
+				continue
+			}
+
+			l := append([]string(nil), labels...)
+			l[i] = alt.Label
+			conf := alt.Confidence
+			if a.Confidence != 0 {
+				conf *= confidence / a.Confidence
+			}
+			list = append(list, Alternative{Time: t, Confidence: conf, Labels: l})
+		}
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Confidence > list[j].Confidence
+	})
+	return list, nil
+}
@@ -0,0 +1,86 @@
+package when_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/olebedev/when/rules/en"
+	"github.com/olebedev/when/rules/es"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlternatives(t *testing.T) {
+	o := &rules.Options{Distance: 5, MatchByOrder: true, Alternatives: true}
+	friday := today.AddDate(0, 0, 2)
+
+	fixt := []struct {
+		all          []rules.Rule
+		text         string
+		time         time.Time
+		confidence   float64
+		labels       []string
+		alternatives []when.Alternative
+	}{
+		// not ambiguous
+		{en.All, "at 5pm", today.Add(17 * time.Hour), 1, nil, nil},
+		{en.All, "at 05:30", today.Add(5*time.Hour + 30*time.Minute), 1, nil, nil},
+		{en.All, "at 7:30", today.Add(7*time.Hour + 30*time.Minute), 1, nil, nil},
+		{en.All, "friday", friday.Add(9 * time.Hour), 1, nil, nil},
+		{common.All, "13/4", time.Date(2016, 4, 13, 10, 0, 0, 0, time.UTC), 1, nil, nil},
+		// the hour of a 12-hour clock
+		{en.All, "at 7 o'clock", today.Add(7 * time.Hour), 0.6, []string{"am"}, []when.Alternative{
+			{Time: today.Add(19 * time.Hour), Confidence: 0.4, Labels: []string{"pm"}},
+		}},
+		{es.All, "a las 8", today.Add(8 * time.Hour), 0.6, []string{"am"}, []when.Alternative{
+			{Time: today.Add(20 * time.Hour), Confidence: 0.4, Labels: []string{"pm"}},
+		}},
+		// the order of the day and the month
+		{common.All, "3/4", time.Date(2016, 4, 3, 10, 0, 0, 0, time.UTC), 0.6, []string{"day/month"}, []when.Alternative{
+			{Time: time.Date(2016, 3, 4, 10, 0, 0, 0, time.UTC), Confidence: 0.4, Labels: []string{"month/day"}},
+		}},
+		// the week after of a weekday said with its date is the same time
+		{en.All, "Tuesday March 1", time.Date(2016, 3, 1, 9, 0, 0, 0, time.UTC), 1, nil, nil},
+		{en.All, "next Tuesday March 1", time.Date(2016, 3, 1, 9, 0, 0, 0, time.UTC), 1, nil, nil},
+		// both, each alternative changes one of them
+		{en.All, "next friday at 7 o'clock", friday.Add(7 * time.Hour), 0.36, []string{"upcoming", "am"}, []when.Alternative{
+			{Time: friday.AddDate(0, 0, 7).Add(7 * time.Hour), Confidence: 0.24, Labels: []string{"week after", "am"}},
+			{Time: friday.Add(19 * time.Hour), Confidence: 0.24, Labels: []string{"upcoming", "pm"}},
+		}},
+	}
+
+	for _, f := range fixt {
+		w := newParser(o, f.all)
+		res, err := w.Parse(f.text, base)
+		require.Nil(t, err, f.text)
+		require.NotNil(t, res, f.text)
+		require.Equal(t, f.time, res.Time, f.text)
+		require.InDelta(t, f.confidence, res.Confidence, 1e-9, f.text)
+		require.Equal(t, f.labels, res.Labels, f.text)
+		require.Len(t, res.Alternatives, len(f.alternatives), f.text)
+		for i, a := range f.alternatives {
+			require.Equal(t, a.Time, res.Alternatives[i].Time, f.text)
+			require.InDelta(t, a.Confidence, res.Alternatives[i].Confidence, 1e-9, f.text)
+			require.Equal(t, a.Labels, res.Alternatives[i].Labels, f.text)
+		}
+	}
+}
+
+func TestAlternativesOff(t *testing.T) {
+	w := newParser(nil, en.All)
+
+	res, err := w.Parse("at 7 o'clock", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.InDelta(t, 0.6, res.Confidence, 1e-9)
+	require.Equal(t, []string{"am"}, res.Labels)
+	require.Nil(t, res.Alternatives)
+
+	res, err = w.Parse("next Tuesday March 1", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.InDelta(t, 1, res.Confidence, 1e-9)
+	require.Nil(t, res.Labels)
+}
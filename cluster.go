@@ -0,0 +1,80 @@
+package when
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/olebedev/when/rules"
+)
+
+// breaks part the sentences and the clauses, the matches on both sides of
+// one don't go together with rules.ClusterByTokens
+const breaks = ".!?;\n。！？；؛؟"
+
+// near tells if the match spanning from left to right goes to the cluster
+// ending at end, see rules.Clustering
+func (p *Parser) near(text string, end, left, right int) bool {
+	if p.options.Clustering != rules.ClusterByTokens {
+		return left <= end+p.options.Distance
+	}
+	if left <= end {
+		return true
+	}
+
+	gap := text[end:left]
+	if strings.ContainsAny(gap, breaks) {
+		return false
+	}
+
+	words, n := tokens(gap), 0
+	for _, w := range words {
+		if !p.connector(w) {
+			n++
+		}
+	}
+	if n <= p.options.Tokens {
+		return true
+	}
+
+	// a connector brings the match in from further, like "at" of "at 3pm"
+	if own := tokens(text[left:right]); len(own) > 0 && p.connector(own[0]) {
+		return true
+	}
+	return len(words) > 0 && p.connector(words[len(words)-1])
+}
+
+// connector tells if the word is one of the connectors of the parser
+func (p *Parser) connector(word string) bool {
+	return p.connectors[strings.ToLower(word)]
+}
+
+// tokens splits the text into words: the runs of letters, digits and
+// apostrophes inside them, and the single characters of the scripts written
+// without spaces, like "的" or "の"
+func tokens(text string) []string {
+	var words []string
+	start := -1
+	flush := func(i int) {
+		if start >= 0 {
+			words = append(words, strings.TrimRight(text[start:i], "'’"))
+			start = -1
+		}
+	}
+
+	for i, r := range text {
+		switch {
+		case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana):
+			flush(i)
+			words = append(words, string(r))
+		case unicode.IsLetter(r), unicode.IsDigit(r), unicode.IsMark(r):
+			if start < 0 {
+				start = i
+			}
+		case (r == '\'' || r == '’') && start >= 0:
+		default:
+			flush(i)
+		}
+	}
+	flush(len(text))
+	return words
+}
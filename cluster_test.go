@@ -0,0 +1,62 @@
+package when_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/en"
+	"github.com/olebedev/when/rules/ja"
+	"github.com/olebedev/when/rules/ru"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterByTokens(t *testing.T) {
+	o := &rules.Options{Clustering: rules.ClusterByTokens, Tokens: 1, MatchByOrder: true}
+
+	fixt := []struct {
+		all        []rules.Rule
+		connectors []string
+		text       string
+		phrase     string
+		time       time.Time
+	}{
+		// a connector brings the time in
+		{en.All, en.CONNECTORS, "tomorrow, after my very long meeting, at 3pm",
+			"tomorrow, after my very long meeting, at 3pm", tomorrow.Add(15 * time.Hour)},
+		{ru.All, ru.CONNECTORS, "завтра, после встречи, в 15:00",
+			"завтра, после встречи, в 15:00", tomorrow.Add(15 * time.Hour)},
+		{ja.All, ja.CONNECTORS, "明日、会議の後で、午後3時",
+			"明日、会議の後で、午後3時", tomorrow.Add(15 * time.Hour)},
+		// the words between the matches
+		{en.All, en.CONNECTORS, "tomorrow Bob 3pm", "tomorrow Bob 3pm", tomorrow.Add(15 * time.Hour)},
+		{en.All, en.CONNECTORS, "tomorrow Bob and Alice 3pm", "tomorrow", tomorrow.Add(9 * time.Hour)},
+		// a break parts the matches
+		{en.All, en.CONNECTORS, "at 5pm; 6/10 of them", "5pm", today.Add(17 * time.Hour)},
+		{en.All, en.CONNECTORS, "tomorrow. At 3pm", "tomorrow", tomorrow.Add(9 * time.Hour)},
+	}
+
+	for _, f := range fixt {
+		w := newParser(o, f.all)
+		w.Connect(f.connectors...)
+		res, err := w.Parse(f.text, base)
+		require.Nil(t, err, f.text)
+		require.NotNil(t, res, f.text)
+		require.Equal(t, f.phrase, res.Text, f.text)
+		require.Equal(t, f.time, res.Time, f.text)
+	}
+}
+
+func TestClusterByDistance(t *testing.T) {
+	w := newParser(nil, en.All)
+
+	res, err := w.Parse("tomorrow, after my very long meeting, at 3pm", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, "tomorrow", res.Text)
+
+	res, err = w.Parse("at 5pm; 6/10 of them", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, "5pm; 6/10", res.Text)
+}
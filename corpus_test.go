@@ -0,0 +1,109 @@
+package when_test
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules/en"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNoDates reads the sentences of testdata/nodates, none of them has a
+// date or a time in it. when.Any reads the sentences of every language.
+func TestNoDates(t *testing.T) {
+	parsers := map[string]interface {
+		Parse(string, time.Time) (*when.Result, error)
+	}{
+		"en": when.EN, "de": when.DE, "fr": when.FR, "es": when.ES,
+		"br": when.BR, "nl": when.NL, "ru": when.RU, "uk": when.UK,
+		"pl": when.PL, "ar": when.AR, "any": when.Any,
+	}
+
+	for name, w := range parsers {
+		files := []string{filepath.Join("testdata", "nodates", name+".txt")}
+		if name == "any" {
+			var err error
+			files, err = filepath.Glob(filepath.Join("testdata", "nodates", "*.txt"))
+			require.Nil(t, err, name)
+		}
+
+		for _, file := range files {
+			f, err := os.Open(file)
+			require.Nil(t, err, name)
+
+			s := bufio.NewScanner(f)
+			for s.Scan() {
+				line := strings.TrimSpace(s.Text())
+				if line == "" || strings.HasPrefix(line, "#") {
+					continue
+				}
+				res, err := w.Parse(line, base)
+				require.Nil(t, err, "[%s] %s", name, line)
+				if res != nil {
+					t.Errorf("[%s] %q: found %q", name, line, res.Text)
+				}
+			}
+			require.Nil(t, s.Err(), name)
+			f.Close()
+		}
+	}
+}
+
+func TestGuard(t *testing.T) {
+	// the guards keep the months said with a day
+	fixt := []struct {
+		text string
+		time time.Time
+	}{
+		{"this march 5th", time.Date(2016, 3, 5, 10, 0, 0, 0, time.UTC)},
+		{"see you this may 3rd", time.Date(2016, 5, 3, 10, 0, 0, 0, time.UTC)},
+		{"that may 5th is fine", time.Date(2016, 5, 5, 10, 0, 0, 0, time.UTC)},
+	}
+
+	for _, f := range fixt {
+		res, err := when.EN.Parse(f.text, base)
+		require.Nil(t, err, f.text)
+		require.NotNil(t, res, f.text)
+		require.Equal(t, f.time, res.Time, f.text)
+	}
+}
+
+func TestStop(t *testing.T) {
+	// the stops take the numbers only
+	res, err := when.EN.Parse("meet in room 5 at 5pm", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, "5pm", res.Text)
+
+	res, err = when.EN.Parse("we won 3-2 tomorrow", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, "tomorrow", res.Text)
+
+	// a stop of its own
+	w := newParser(nil, en.All)
+	res, err = w.Parse("sprint 1230 starts", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, today.Add(12*time.Hour+30*time.Minute), res.Time)
+
+	w.Stop(regexp.MustCompile(`(?i)\bsprint\s+(\d+)`))
+	res, err = w.Parse("sprint 1230 starts", base)
+	require.Nil(t, err)
+	require.Nil(t, res)
+
+	// the whole match when the group takes no part in it
+	w = newParser(nil, en.All)
+	w.Stop(regexp.MustCompile(`(?i)\b(?:sprint\s+(\d+)|build\s+\d+)`))
+	for _, text := range []string{"sprint 1230 starts", "build 1230 starts"} {
+		res, err = w.Parse(text, base)
+		require.Nil(t, err, text)
+		require.Nil(t, res, text)
+	}
+}
@@ -0,0 +1,23 @@
+package middleware
+
+// BR_WORDS holds the Brazilian Portuguese abbreviations and misspellings
+// the rules don't know
+var BR_WORDS = map[string]string{
+	"hj":      "hoje",
+	"amanha":  "amanhã",
+	"amnh":    "amanhã",
+	"hr":      "hora",
+	"hrs":     "horas",
+	"seg.":    "segunda",
+	"ter.":    "terça",
+	"qua.":    "quarta",
+	"qui.":    "quinta",
+	"sex.":    "sexta",
+	"sáb.":    "sábado",
+	"dom.":    "domingo",
+	"proxima": "próxima",
+	"proximo": "próximo",
+}
+
+// BR expands the Brazilian Portuguese abbreviations and fixes the misspellings, "hj" is "hoje"
+var BR = Words(BR_WORDS)
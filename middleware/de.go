@@ -0,0 +1,26 @@
+package middleware
+
+// DE_WORDS holds the German abbreviations and misspellings the rules don't
+// know
+var DE_WORDS = map[string]string{
+	"mo.":         "Montag",
+	"di.":         "Dienstag",
+	"mi.":         "Mittwoch",
+	"do.":         "Donnerstag",
+	"fr.":         "Freitag",
+	"sa.":         "Samstag",
+	"so.":         "Sonntag",
+	"wo.":         "Woche",
+	"morgn":       "morgen",
+	"übermorgn":   "übermorgen",
+	"uebermorgen": "übermorgen",
+	"naechste":    "nächste",
+	"naechsten":   "nächsten",
+	"naechster":   "nächster",
+	"naechstes":   "nächstes",
+	"maerz":       "März",
+	"waehrend":    "während",
+}
+
+// DE expands the German abbreviations and fixes the misspellings, "am Mo." is "am Montag"
+var DE = Words(DE_WORDS)
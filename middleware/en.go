@@ -0,0 +1,56 @@
+package middleware
+
+// EN_WORDS holds the English abbreviations and misspellings the rules
+// don't know
+var EN_WORDS = map[string]string{
+	"tmr":       "tomorrow",
+	"tmrw":      "tomorrow",
+	"tmrow":     "tomorrow",
+	"2mrw":      "tomorrow",
+	"2moro":     "tomorrow",
+	"2morow":    "tomorrow",
+	"2morrow":   "tomorrow",
+	"tomoro":    "tomorrow",
+	"tomorow":   "tomorrow",
+	"tommorow":  "tomorrow",
+	"tommorrow": "tomorrow",
+	"tomorrw":   "tomorrow",
+	"2day":      "today",
+	"tdy":       "today",
+	"yday":      "yesterday",
+	"ystrdy":    "yesterday",
+	"yesterdy":  "yesterday",
+	"yesturday": "yesterday",
+	"tonite":    "tonight",
+	"2nite":     "tonight",
+	"2night":    "tonight",
+	"nxt":       "next",
+	"lst":       "last",
+	"wk":        "week",
+	"wks":       "weeks",
+	"mnth":      "month",
+	"mth":       "month",
+	"mnths":     "months",
+	"mths":      "months",
+	"yr":        "year",
+	"yrs":       "years",
+	"tues":      "tuesday",
+	"tues.":     "tuesday",
+	"weds":      "wednesday",
+	"weds.":     "wednesday",
+	"thur":      "thursday",
+	"thur.":     "thursday",
+	"thurs":     "thursday",
+	"thurs.":    "thursday",
+	"wendesday": "wednesday",
+	"wensday":   "wednesday",
+	"thursady":  "thursday",
+	"saturaday": "saturday",
+	"morn":      "morning",
+	"arvo":      "afternoon",
+	"oclock":    "o'clock",
+}
+
+// EN expands the English abbreviations and fixes the misspellings,
+// "tmrw" is "tomorrow" and "nxt wk" is "next week"
+var EN = Words(EN_WORDS)
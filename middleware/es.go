@@ -0,0 +1,25 @@
+package middleware
+
+// ES_WORDS holds the Spanish abbreviations and misspellings the rules don't
+// know
+var ES_WORDS = map[string]string{
+	"lun.":    "lunes",
+	"mar.":    "martes",
+	"mié.":    "miércoles",
+	"mie.":    "miércoles",
+	"jue.":    "jueves",
+	"vie.":    "viernes",
+	"sáb.":    "sábado",
+	"sab.":    "sábado",
+	"sab":     "sábado",
+	"dom.":    "domingo",
+	"manana":  "mañana",
+	"mñn":     "mañana",
+	"hr":      "hora",
+	"hrs":     "horas",
+	"proxima": "próxima",
+	"proximo": "próximo",
+}
+
+// ES expands the Spanish abbreviations and fixes the misspellings, "mñn" is "mañana"
+var ES = Words(ES_WORDS)
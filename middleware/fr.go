@@ -0,0 +1,22 @@
+package middleware
+
+// FR_WORDS holds the French abbreviations and misspellings the rules don't
+// know
+var FR_WORDS = map[string]string{
+	"lun.":   "lundi",
+	"mar.":   "mardi",
+	"mer.":   "mercredi",
+	"jeu.":   "jeudi",
+	"ven.":   "vendredi",
+	"sam.":   "samedi",
+	"dim.":   "dimanche",
+	"dem1":   "demain",
+	"auj":    "aujourd'hui",
+	"ajd":    "aujourd'hui",
+	"ds":     "dans",
+	"sem.":   "semaine",
+	"proch.": "prochain",
+}
+
+// FR expands the French abbreviations and fixes the misspellings, "lun." is "lundi"
+var FR = Words(FR_WORDS)
@@ -0,0 +1,138 @@
+// Package middleware holds the normalizers cleaning up the text before the
+// rules are applied: the punctuation, the full-width characters, the
+// abbreviations and the misspellings of each language. All of them keep
+// the offsets, so the result still points into the original text.
+//
+//	w := when.New(nil)
+//	w.Normalize(middleware.Punctuation, middleware.FullWidth, middleware.EN)
+//	w.Add(en.All...)
+package middleware
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/olebedev/when/rules"
+)
+
+// edit replaces the bytes of the text from from to to with text
+type edit struct {
+	from, to int
+	text     string
+}
+
+// apply applies the edits sorted by from and not overlapping, the bytes
+// of a replacement point to the start of the bytes it replaced
+func apply(text string, edits []edit) (string, rules.Offsets) {
+	if len(edits) == 0 {
+		return text, nil
+	}
+
+	var b strings.Builder
+	b.Grow(len(text))
+	offsets := make(rules.Offsets, 0, len(text)+1)
+
+	last := 0
+	for _, e := range edits {
+		b.WriteString(text[last:e.from])
+		for i := last; i < e.from; i++ {
+			offsets = append(offsets, i)
+		}
+		b.WriteString(e.text)
+		for i := 0; i < len(e.text); i++ {
+			offsets = append(offsets, e.from)
+		}
+		last = e.to
+	}
+	b.WriteString(text[last:])
+	for i := last; i <= len(text); i++ {
+		offsets = append(offsets, i)
+	}
+
+	return b.String(), offsets
+}
+
+// Runes returns a Normalizer replacing the runes of the text, replace
+// returns the replacement of a rune and whether to replace it at all
+func Runes(replace func(rune) (string, bool)) rules.Normalizer {
+	return func(text string) (string, rules.Offsets) {
+		var edits []edit
+		for i, r := range text {
+			if s, ok := replace(r); ok {
+				edits = append(edits, edit{i, i + utf8.RuneLen(r), s})
+			}
+		}
+		return apply(text, edits)
+	}
+}
+
+// Words returns a Normalizer replacing the words of the text found in the
+// table in any case. A word is a run of letters, digits and apostrophes, a
+// key ending with a dot matches the word followed by a dot, like "mon."
+func Words(table map[string]string) rules.Normalizer {
+	lower := make(map[string]string, len(table))
+	for k, v := range table {
+		lower[strings.ToLower(k)] = v
+	}
+
+	return func(text string) (string, rules.Offsets) {
+		var edits []edit
+		for i := 0; i < len(text); {
+			r, n := utf8.DecodeRuneInString(text[i:])
+			if !isWord(r) {
+				i += n
+				continue
+			}
+
+			end := i + n
+			for end < len(text) {
+				r, n := utf8.DecodeRuneInString(text[end:])
+				if !isWord(r) {
+					break
+				}
+				end += n
+			}
+
+			word := strings.ToLower(text[i:end])
+			if end < len(text) && text[end] == '.' {
+				if s, ok := lower[word+"."]; ok {
+					edits = append(edits, edit{i, end + 1, s})
+					i = end + 1
+					continue
+				}
+			}
+			if s, ok := lower[word]; ok {
+				edits = append(edits, edit{i, end, s})
+			}
+			i = end
+		}
+		return apply(text, edits)
+	}
+}
+
+func isWord(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.Is(unicode.Mn, r) || r == '\''
+}
+
+// Chain returns a Normalizer applying the normalizers one after another
+func Chain(n ...rules.Normalizer) rules.Normalizer {
+	return func(text string) (string, rules.Offsets) {
+		var offsets rules.Offsets
+		for _, f := range n {
+			var o rules.Offsets
+			text, o = f(text)
+			switch {
+			case o == nil:
+			case offsets == nil:
+				offsets = o
+			default:
+				for i := range o {
+					o[i] = offsets.Map(o[i])
+				}
+				offsets = o
+			}
+		}
+		return text, offsets
+	}
+}
@@ -0,0 +1,92 @@
+package middleware_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/middleware"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/stretchr/testify/require"
+)
+
+var null = time.Date(2016, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+type Fixture struct {
+	Text   string
+	Index  int
+	Phrase string
+	Diff   time.Duration
+}
+
+func ApplyFixtures(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d - %s", name, i, f.Text)
+		require.NotNil(t, res, "[%s] res #%d - %s", name, i, f.Text)
+		require.Equal(t, f.Index, res.Index, "[%s] index #%d - %s", name, i, f.Text)
+		require.Equal(t, f.Phrase, res.Text, "[%s] text #%d - %s", name, i, f.Text)
+		require.Equal(t, f.Diff, res.Time.Sub(null), "[%s] diff #%d - %s", name, i, f.Text)
+	}
+}
+
+func newParser(all []rules.Rule, n ...rules.Normalizer) *when.Parser {
+	w := when.New(nil)
+	w.Normalize(n...)
+	w.Add(all...)
+	w.Add(common.All...)
+	return w
+}
+
+func TestWords(t *testing.T) {
+	n := middleware.Words(map[string]string{
+		"tmrw": "tomorrow",
+		"mon.": "monday",
+	})
+
+	fixt := []struct {
+		text, want string
+	}{
+		{"see you tmrw", "see you tomorrow"},
+		{"see you TMRW!", "see you tomorrow!"},
+		{"tmrw, tmrw", "tomorrow, tomorrow"},
+		{"on mon. at 5", "on monday at 5"},
+		{"on mon at 5", "on mon at 5"},
+		{"tmrws", "tmrws"},
+		{"", ""},
+	}
+
+	for i, f := range fixt {
+		got, offsets := n(f.text)
+		require.Equal(t, f.want, got, "#%d %s", i, f.text)
+		if got == f.text {
+			require.Nil(t, offsets, "#%d %s", i, f.text)
+		} else {
+			require.Len(t, offsets, len(got)+1, "#%d %s", i, f.text)
+			require.Equal(t, len(f.text), offsets.Map(len(got)), "#%d %s", i, f.text)
+		}
+	}
+
+	got, offsets := n("on mon. at 5")
+	require.Equal(t, "on monday at 5", got)
+	require.Equal(t, 3, offsets.Map(3))
+	require.Equal(t, 3, offsets.Map(8))
+	require.Equal(t, 7, offsets.Map(9))
+	require.Equal(t, 11, offsets.Map(13))
+}
+
+func TestChain(t *testing.T) {
+	n := middleware.Chain(middleware.FullWidth, middleware.EN, rules.Digits)
+
+	got, offsets := n("ｔｍｒｗ at ٥pm")
+	require.Equal(t, "tomorrow at 5pm", got)
+	require.Equal(t, 0, offsets.Map(0))
+	require.Equal(t, 12, offsets.Map(8))
+	require.Equal(t, 16, offsets.Map(12))
+	require.Equal(t, 20, offsets.Map(len(got)))
+
+	got, offsets = middleware.Chain()("as is")
+	require.Equal(t, "as is", got)
+	require.Nil(t, offsets)
+}
@@ -0,0 +1,17 @@
+package middleware
+
+// NL_WORDS holds the Dutch abbreviations and misspellings the rules don't
+// know
+var NL_WORDS = map[string]string{
+	"morge":     "morgen",
+	"overmorge": "overmorgen",
+	"min":       "minuten",
+	"min.":      "minuten",
+	"sec":       "seconden",
+	"sec.":      "seconden",
+	"wk":        "week",
+	"volg.":     "volgende",
+}
+
+// NL expands the Dutch abbreviations and fixes the misspellings, "morge" is "morgen"
+var NL = Words(NL_WORDS)
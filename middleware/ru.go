@@ -0,0 +1,28 @@
+package middleware
+
+// RU_WORDS holds the Russian abbreviations and misspellings the rules don't
+// know
+var RU_WORDS = map[string]string{
+	"мин":         "минут",
+	"мин.":        "минут",
+	"сек":         "секунд",
+	"сек.":        "секунд",
+	"ч":           "час",
+	"ч.":          "час",
+	"нед":         "недель",
+	"нед.":        "недель",
+	"мес":         "месяцев",
+	"мес.":        "месяцев",
+	"след":        "следующий",
+	"след.":       "следующий",
+	"сёдня":       "сегодня",
+	"седня":       "сегодня",
+	"сення":       "сегодня",
+	"севодня":     "сегодня",
+	"завтро":      "завтра",
+	"послезавтро": "послезавтра",
+	"вчира":       "вчера",
+}
+
+// RU expands the Russian abbreviations and fixes the misspellings, "через 5 мин" is "через 5 минут"
+var RU = Words(RU_WORDS)
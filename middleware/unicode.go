@@ -0,0 +1,37 @@
+package middleware
+
+import "unicode"
+
+// Punctuation replaces the typographic quotes, dashes and spaces with the
+// ASCII ones and drops the invisible characters, "o’clock" is "o'clock"
+var Punctuation = Runes(func(r rune) (string, bool) {
+	switch r {
+	case '‘', '’', '‚', '‛', '′', 'ʼ':
+		return "'", true
+	case '“', '”', '„', '‟', '″', '«', '»':
+		return "\"", true
+	case '‐', '‑', '‒', '–', '—', '―', '−':
+		return "-", true
+	case '…':
+		return "...", true
+	case '\u200b', '\u200c', '\u200d', '\u2060', '\ufeff', '\u00ad':
+		return "", true
+	}
+	if r != ' ' && unicode.Is(unicode.Zs, r) {
+		return " ", true
+	}
+	return "", false
+})
+
+// FullWidth replaces the full-width forms of the ASCII characters used in
+// the East Asian texts, "１０：３０" is "10:30". The Japanese and Chinese
+// rules read them as they are, it is for the other languages.
+var FullWidth = Runes(func(r rune) (string, bool) {
+	switch {
+	case r >= '！' && r <= '～':
+		return string(r - '！' + '!'), true
+	case r == '\u3000':
+		return " ", true
+	}
+	return "", false
+})
@@ -0,0 +1,47 @@
+package middleware_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when/middleware"
+	"github.com/olebedev/when/rules/en"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPunctuation(t *testing.T) {
+	fixt := []struct {
+		text, want string
+	}{
+		{"7 o’clock", "7 o'clock"},
+		{"“tomorrow”", "\"tomorrow\""},
+		{"10–12", "10-12"},
+		{"wait…", "wait..."},
+		{"5 pm", "5 pm"},
+		{"to\u200bmorrow", "tomorrow"},
+		{"plain text", "plain text"},
+	}
+
+	for i, f := range fixt {
+		got, _ := middleware.Punctuation(f.text)
+		require.Equal(t, f.want, got, "#%d %s", i, f.text)
+	}
+
+	w := newParser(en.All, middleware.Punctuation)
+	ApplyFixtures(t, "middleware.Punctuation", w, []Fixture{
+		{"at 7 o’clock", 3, "7 o’clock", 7 * time.Hour},
+		{"«tomorrow»", 2, "tomorrow", (24 + 9) * time.Hour},
+		{"at 5 pm", 3, "5 pm", 17 * time.Hour},
+	})
+}
+
+func TestFullWidth(t *testing.T) {
+	got, _ := middleware.FullWidth("１０：３０ｐｍ　ｏｋ")
+	require.Equal(t, "10:30pm ok", got)
+
+	w := newParser(en.All, middleware.FullWidth)
+	ApplyFixtures(t, "middleware.FullWidth", w, []Fixture{
+		{"at １０：３０", 3, "１０：３０", 10*time.Hour + 30*time.Minute},
+		{"ｔｏｍｏｒｒｏｗ", 0, "ｔｏｍｏｒｒｏｗ", (24 + 9) * time.Hour},
+	})
+}
@@ -0,0 +1,95 @@
+package middleware_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when/middleware"
+	"github.com/olebedev/when/rules/br"
+	"github.com/olebedev/when/rules/de"
+	"github.com/olebedev/when/rules/en"
+	"github.com/olebedev/when/rules/es"
+	"github.com/olebedev/when/rules/fr"
+	"github.com/olebedev/when/rules/nl"
+	"github.com/olebedev/when/rules/ru"
+)
+
+func TestEN(t *testing.T) {
+	fixt := []Fixture{
+		{"see you tmrw at 5pm", 8, "tmrw at 5pm", (24 + 17) * time.Hour},
+		{"nxt wk", 0, "nxt wk", (5*24 + 9) * time.Hour},
+		{"call me 2moro", 8, "2moro", (24 + 9) * time.Hour},
+		{"on thurs", 3, "thurs", (24 + 9) * time.Hour},
+		{"in 2 wks", 0, "in 2 wks", 14 * 24 * time.Hour},
+		{"tonite", 0, "tonite", 20 * time.Hour},
+	}
+
+	w := newParser(en.All, middleware.EN)
+	ApplyFixtures(t, "middleware.EN", w, fixt)
+}
+
+func TestRU(t *testing.T) {
+	fixt := []Fixture{
+		{"через 5 мин", 0, "через 5 мин", 5 * time.Minute},
+		{"через 10 сек.", 0, "через 10 сек.", 10 * time.Second},
+		{"через 2 ч", 0, "через 2 ч", 2 * time.Hour},
+		{"сёдня в 5 вечера", 0, "сёдня в 5 вечера", 17 * time.Hour},
+		{"завтро", 0, "завтро", 24 * time.Hour},
+	}
+
+	w := newParser(ru.All, middleware.RU)
+	ApplyFixtures(t, "middleware.RU", w, fixt)
+}
+
+func TestDE(t *testing.T) {
+	fixt := []Fixture{
+		{"am Mo.", 3, "Mo.", 5 * 24 * time.Hour},
+		{"übermorgn", 0, "übermorgn", 2 * 24 * time.Hour},
+		{"naechste Woche", 0, "naechste Woche", (5*24 + 9) * time.Hour},
+	}
+
+	w := newParser(de.All, middleware.DE)
+	ApplyFixtures(t, "middleware.DE", w, fixt)
+}
+
+func TestFR(t *testing.T) {
+	fixt := []Fixture{
+		{"lun.", 0, "lun.", 5 * 24 * time.Hour},
+		{"dem1", 0, "dem1", 24 * time.Hour},
+		{"ds 2 jours", 0, "ds 2 jours", 2 * 24 * time.Hour},
+	}
+
+	w := newParser(fr.All, middleware.FR)
+	ApplyFixtures(t, "middleware.FR", w, fixt)
+}
+
+func TestES(t *testing.T) {
+	fixt := []Fixture{
+		{"el vie.", 3, "vie.", 2 * 24 * time.Hour},
+		{"mñn", 0, "mñn", 24 * time.Hour},
+		{"en 2 hrs", 0, "en 2 hrs", 2 * time.Hour},
+	}
+
+	w := newParser(es.All, middleware.ES)
+	ApplyFixtures(t, "middleware.ES", w, fixt)
+}
+
+func TestNL(t *testing.T) {
+	fixt := []Fixture{
+		{"morge", 0, "morge", (24 + 8) * time.Hour},
+		{"over 5 min", 0, "over 5 min", 5 * time.Minute},
+	}
+
+	w := newParser(nl.All, middleware.NL)
+	ApplyFixtures(t, "middleware.NL", w, fixt)
+}
+
+func TestBR(t *testing.T) {
+	fixt := []Fixture{
+		{"amanha", 0, "amanha", 24 * time.Hour},
+		{"hj", 0, "hj", 0},
+	}
+
+	w := newParser(br.All, middleware.BR)
+	ApplyFixtures(t, "middleware.BR", w, fixt)
+}
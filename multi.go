@@ -0,0 +1,317 @@
+package when
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/olebedev/when/rules/ar"
+	"github.com/olebedev/when/rules/br"
+	"github.com/olebedev/when/rules/de"
+	"github.com/olebedev/when/rules/en"
+	"github.com/olebedev/when/rules/es"
+	"github.com/olebedev/when/rules/fr"
+	"github.com/olebedev/when/rules/ja"
+	"github.com/olebedev/when/rules/nl"
+	"github.com/olebedev/when/rules/pl"
+	"github.com/olebedev/when/rules/ru"
+	"github.com/olebedev/when/rules/uk"
+)
+
+// Locale is a language Multi detects in the text and a parser for it
+type Locale struct {
+	// Name is reported in Result.Locale, like "en"
+	Name string
+	// Parser holds the rules of the language
+	Parser *Parser
+	// Scripts are the writing systems the language uses
+	Scripts []*unicode.RangeTable
+	// Keywords are the words the detection looks for, the words of the
+	// languages written without spaces are found anywhere in the text
+	Keywords []string
+}
+
+// Multi is a parser for the texts in several languages. It detects the
+// languages of the text and runs the parsers of them separately, so the
+// rules of one language never interfere with the others.
+type Multi struct {
+	locales  []*Locale
+	fallback *Locale
+}
+
+// NewMulti returns Multi for the given locales, the first one goes first
+// when the detection can't tell them apart and parses the texts with no
+// hints of a language, see SetDefault.
+func NewMulti(locales ...*Locale) *Multi {
+	m := &Multi{}
+	m.Add(locales...)
+	return m
+}
+
+// Add adds the given locales to the detection.
+func (m *Multi) Add(locales ...*Locale) {
+	m.locales = append(m.locales, locales...)
+	if m.fallback == nil && len(m.locales) > 0 {
+		m.fallback = m.locales[0]
+	}
+}
+
+// SetDefault sets the locale parsing the texts Detect finds no language
+// in, like "17:30" or "room 1200", nil leaves them unparsed.
+func (m *Multi) SetDefault(l *Locale) {
+	m.fallback = l
+}
+
+// Detect returns the names of the locales the text is likely written in,
+// the most likely first. It is empty when there are no hints in the text.
+func (m *Multi) Detect(text string) []string {
+	var names []string
+	for _, l := range m.detect(text) {
+		names = append(names, l.Name)
+	}
+	return names
+}
+
+// Parse parses the text with the most likely locale and falls back to the
+// other detected ones if it finds nothing, and to the default locale last,
+// Result.Locale tells the one that matched. It returns nil, nil if none of
+// them matches.
+func (m *Multi) Parse(text string, base time.Time) (*Result, error) {
+	locales := m.detect(text)
+	if m.fallback != nil && !contains(locales, m.fallback) {
+		locales = append(locales, m.fallback)
+	}
+
+	// a number labeled in a language of the text is no time in any of
+	// them, the stops of the languages not found in it don't apply
+	var stops []*regexp.Regexp
+	for _, l := range locales {
+		stops = append(stops, l.Parser.stops...)
+	}
+
+	for _, l := range locales {
+		res, err := l.Parser.parse(text, base, stops, nil)
+		if err != nil {
+			return nil, err
+		}
+		if res != nil {
+			res.Locale = l.Name
+			return res, nil
+		}
+	}
+	return nil, nil
+}
+
+// detect returns the locales having any hints in the text, the most
+// likely first
+func (m *Multi) detect(text string) []*Locale {
+	var locales []*Locale
+	for _, l := range m.rank(text) {
+		if l.score > 0 {
+			locales = append(locales, l.Locale)
+		}
+	}
+	return locales
+}
+
+// contains tells if the locale is in the list
+func contains(list []*Locale, l *Locale) bool {
+	for _, x := range list {
+		if x == l {
+			return true
+		}
+	}
+	return false
+}
+
+type scored struct {
+	*Locale
+	score float64
+}
+
+// rank orders the locales by the keywords found in the text and the share
+// of the letters written in their scripts. A keyword outweighs the
+// scripts, they only tell apart the languages without any keywords. The
+// letters of the script of the default locale are no hint, the text goes
+// to it anyway, so e.g. a locale without keywords in a text written in
+// Latin letters scores 0 with English as the default.
+func (m *Multi) rank(text string) []scored {
+	lower := strings.ToLower(text)
+	words := make(map[string]int)
+	for _, w := range strings.FieldsFunc(lower, func(r rune) bool { return !unicode.IsLetter(r) }) {
+		words[w]++
+	}
+
+	letters := 0
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			letters++
+		}
+	}
+
+	ranked := make([]scored, len(m.locales))
+	for i, l := range m.locales {
+		ranked[i] = scored{Locale: l}
+
+		for _, k := range l.Keywords {
+			if spaced(k) {
+				ranked[i].score += float64(words[k])
+			} else {
+				ranked[i].score += float64(strings.Count(lower, k))
+			}
+		}
+
+		if letters > 0 {
+			in := 0
+			for _, r := range text {
+				if unicode.IsLetter(r) && unicode.IsOneOf(l.Scripts, r) && m.hint(r) {
+					in++
+				}
+			}
+			ranked[i].score += float64(in) / float64(letters)
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].score > ranked[j].score
+	})
+	return ranked
+}
+
+// hint tells if the script of the letter is a hint of a language, the one
+// of the default locale is not
+func (m *Multi) hint(r rune) bool {
+	return m.fallback == nil || !unicode.IsOneOf(m.fallback.Scripts, r)
+}
+
+// spaced tells if the keyword is from a language written with spaces
+// between the words
+func spaced(keyword string) bool {
+	for _, r := range keyword {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana) {
+			return false
+		}
+	}
+	return true
+}
+
+// keywords collects the keys of the tables and the labels of a locale with
+// the extra words, leaving out the short ones like "ma" or "nr" which are
+// found in many languages, and the abbreviations of the tables like "out"
+// or "set" which are English words too. The extra words are picked by hand, like the "um"
+// of "um 8", and are all kept.
+func keywords(extra, labels []string, tables ...map[string]int) []string {
+	seen := make(map[string]bool)
+	var list []string
+	add := func(w string, min int) {
+		w = strings.ToLower(w)
+		if seen[w] || (spaced(w) && len([]rune(w)) < min) || strings.ContainsAny(w, " .-") {
+			return
+		}
+		seen[w] = true
+		list = append(list, w)
+	}
+
+	for _, t := range tables {
+		for w := range t {
+			add(w, 4)
+		}
+	}
+	for _, w := range labels {
+		add(w, 3)
+	}
+	for _, w := range extra {
+		add(w, 0)
+	}
+
+	sort.Strings(list)
+	return list
+}
+
+// Locales holds the built-in languages by their names
+var Locales map[string]*Locale
+
+// Any is a parser for all the built-in languages
+var Any *Multi
+
+// initLocales sets up the built-in locales, it goes after the parsers are
+// ready
+func initLocales() {
+	ruMonths := make(map[string]int, len(ru.MONTHS))
+	for k, v := range ru.MONTHS {
+		ruMonths[k] = int(v)
+	}
+
+	latin := []*unicode.RangeTable{unicode.Latin}
+
+	list := []*Locale{
+		{"en", EN, latin, keywords([]string{
+			"today", "tomorrow", "yesterday", "tonight", "morning", "afternoon",
+			"evening", "night", "noon", "midnight", "next", "last", "ago", "week",
+			"weeks", "month", "months", "year", "years", "hour", "hours",
+			"minute", "minutes", "half", "quarter", "past",
+		}, en.LABELS, en.WEEKDAY_OFFSET, en.MONTH_OFFSET, en.INTEGER_WORDS, en.ORDINAL_WORDS)},
+		{"ru", RU, []*unicode.RangeTable{unicode.Cyrillic}, keywords([]string{
+			"сегодня", "завтра", "послезавтра", "вчера", "утром", "вечером",
+			"ночью", "днём", "полночь", "полдень", "через", "назад", "неделе",
+			"неделю", "месяц", "году", "час", "часа", "часов", "минут",
+		}, ru.LABELS, ru.WEEKDAY_OFFSET, ru.INTEGER_WORDS, ruMonths)},
+		{"br", BR, latin, keywords([]string{
+			"hoje", "amanhã", "ontem", "manhã", "noite", "próxima", "próximo",
+			"semana", "mês", "ano", "horas", "minutos", "atrás", "daqui",
+		}, br.LABELS, br.WEEKDAY_OFFSET, br.MONTH_OFFSET, br.INTEGER_WORDS, br.ORDINAL_WORDS)},
+		{"nl", NL, latin, keywords([]string{
+			"vandaag", "morgen", "gisteren", "overmorgen", "vanavond", "vannacht",
+			"ochtend", "middag", "avond", "middernacht", "volgende", "vorige",
+			"week", "maand", "jaar", "uur", "minuten", "geleden", "kwart", "om",
+		}, nl.LABELS, nl.WEEKDAY_OFFSET, nl.MONTH_OFFSET, nl.INTEGER_WORDS, nl.ORDINAL_WORDS)},
+		{"de", DE, latin, keywords([]string{
+			"heute", "morgen", "gestern", "übermorgen", "abend", "nacht",
+			"mittag", "mitternacht", "nächste", "letzte", "woche", "monat",
+			"jahr", "uhr", "stunden", "minuten", "um", "gegen",
+		}, de.LABELS, de.WEEKDAY_OFFSET, de.MONTH_OFFSET, de.INTEGER_WORDS)},
+		{"fr", FR, latin, keywords([]string{
+			"aujourd", "demain", "hier", "soir", "matin", "midi", "minuit",
+			"prochain", "prochaine", "dernier", "semaine", "mois", "année",
+			"heures", "minutes", "dans",
+		}, fr.LABELS, fr.WEEKDAY_OFFSET, fr.MONTH_OFFSET, fr.INTEGER_WORDS)},
+		{"es", ES, latin, keywords([]string{
+			"hoy", "mañana", "ayer", "noche", "medianoche", "mediodía",
+			"próximo", "próxima", "semana", "mes", "año", "horas", "minutos",
+			"hace", "dentro",
+		}, es.LABELS, es.WEEKDAY_OFFSET, es.MONTH_OFFSET, es.INTEGER_WORDS)},
+		{"zh", ZH, []*unicode.RangeTable{unicode.Han}, keywords([]string{
+			"今天", "明天", "昨天", "后天", "前天", "星期", "礼拜", "下午", "上午",
+			"晚上", "早上", "凌晨", "点", "分钟", "小时", "午夜", "中午", "后",
+			"下个", "上个", "下周", "上周",
+		}, nil)},
+		{"ja", JA, []*unicode.RangeTable{unicode.Han, unicode.Hiragana, unicode.Katakana}, keywords([]string{
+			"今日", "明日", "昨日", "明後日", "曜日", "時", "午前", "午後", "今夜",
+			"真夜中", "正午", "後",
+		}, nil, ja.PERIOD_SHIFTS, ja.ERA_OFFSET)},
+		{"uk", UK, []*unicode.RangeTable{unicode.Cyrillic}, keywords([]string{
+			"сьогодні", "післязавтра", "учора", "вчора", "вранці", "ввечері",
+			"вночі", "опівночі", "опівдні", "тому", "тиждень", "тижня", "місяця",
+			"року", "години", "годин", "хвилин", "наступного", "наступної",
+		}, uk.LABELS, uk.WEEKDAY_OFFSET, uk.HOUR_ORDINALS)},
+		{"pl", PL, latin, keywords([]string{
+			"dzisiaj", "dziś", "jutro", "pojutrze", "wczoraj", "rano",
+			"wieczorem", "nocy", "północy", "południe", "temu", "tydzień",
+			"tygodniu", "miesiąc", "roku", "godziny", "godzin", "minut",
+			"następny", "przyszłym", "kwadrans",
+		}, pl.LABELS, pl.WEEKDAY_OFFSET, pl.HOUR_ORDINALS)},
+		{"ar", AR, []*unicode.RangeTable{unicode.Arabic}, keywords([]string{
+			"الساعة", "صباحا", "مساء", "ليلا", "دقائق", "دقيقة", "ساعة", "ساعات",
+			"أيام", "يوم", "أسبوع", "الأسبوع", "شهر", "الشهر", "منذ", "بعد",
+			"القادم", "القادمة", "الماضي", "الماضية",
+		}, ar.LABELS, ar.WEEKDAY_OFFSET, ar.CASUAL_DATES, ar.HOUR_ORDINALS, ar.MONTH_OFFSET)},
+	}
+
+	Locales = make(map[string]*Locale, len(list))
+	for _, l := range list {
+		Locales[l.Name] = l
+	}
+	Any = NewMulti(list...)
+}
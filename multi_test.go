@@ -0,0 +1,131 @@
+package when_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiDetect(t *testing.T) {
+	fixt := []struct {
+		text string
+		want string
+	}{
+		{"see you tomorrow at 5pm", "en"},
+		{"встретимся завтра в 5 вечера", "ru"},
+		{"tot morgen om 17:30", "nl"},
+		{"bis morgen um 17 Uhr", "de"},
+		{"à demain soir", "fr"},
+		{"hasta mañana por la tarde", "es"},
+		{"até amanhã de manhã", "br"},
+		{"明天下午3点", "zh"},
+		{"明日の午後3時", "ja"},
+		{"зустрінемося у п'ятницю ввечері", "uk"},
+		{"do zobaczenia jutro wieczorem", "pl"},
+		{"نلتقي غداً الساعة ٥ مساءً", "ar"},
+		{"созвон tomorrow at 5pm", "en"},
+	}
+
+	for i, f := range fixt {
+		got := when.Any.Detect(f.text)
+		require.NotEmpty(t, got, "#%d %s", i, f.text)
+		require.Equal(t, f.want, got[0], "#%d %s", i, f.text)
+	}
+
+	require.Empty(t, when.Any.Detect("17:30"))
+	// Latin letters are no hint with English as the default
+	require.Empty(t, when.Any.Detect("Release 2.0 is out."))
+}
+
+func TestMultiParse(t *testing.T) {
+	fixt := []struct {
+		text   string
+		locale string
+		phrase string
+		want   time.Time
+	}{
+		{"see you tomorrow at 5pm", "en", "tomorrow at 5pm", tomorrow.Add(17 * time.Hour)},
+		{"встретимся завтра в 5 вечера", "ru", "завтра в 5 вечера", tomorrow.Add(17 * time.Hour)},
+		{"tot morgen om 17:30", "nl", "morgen om 17:30", tomorrow.Add(17*time.Hour + 30*time.Minute)},
+		{"明天下午3点", "zh", "明天下午3点", tomorrow.Add(15 * time.Hour)},
+		{"明日の午後3時", "ja", "明日の午後3時", tomorrow.Add(15 * time.Hour)},
+		// no hints, the first locale goes first
+		{"17:30", "en", "17:30", today.Add(17*time.Hour + 30*time.Minute)},
+	}
+
+	for i, f := range fixt {
+		res, err := when.Any.Parse(f.text, base)
+		require.Nil(t, err, "err #%d", i)
+		require.NotNil(t, res, "res #%d", i)
+		require.Equal(t, f.locale, res.Locale, "locale #%d %s", i, f.text)
+		require.Equal(t, f.phrase, res.Text, "text #%d %s", i, f.text)
+		require.Equal(t, f.want, res.Time, "time #%d %s", i, f.text)
+	}
+
+	res, err := when.Any.Parse("nothing to see here", base)
+	require.Nil(t, err)
+	require.Nil(t, res)
+}
+
+func TestMultiFallback(t *testing.T) {
+	// Russian is detected first, but only English finds the time
+	m := when.NewMulti(when.Locales["ru"], when.Locales["en"])
+
+	res, err := m.Parse("созвон, созвон, созвон at 5pm", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, "en", res.Locale)
+	require.Equal(t, today.Add(17*time.Hour), res.Time)
+}
+
+func TestMultiAny(t *testing.T) {
+	fixt := []struct {
+		text   string
+		locale string
+		phrase string
+		want   time.Time
+	}{
+		// Russian finds nothing, English is the default and goes last
+		{"созвон at 5pm", "en", "5pm", today.Add(17 * time.Hour)},
+		// "no" labels a number in French only
+		{"there is no 5pm meeting", "en", "5pm", today.Add(17 * time.Hour)},
+		// the clock words tell German from Dutch
+		{"morgen um 8", "de", "morgen um 8", tomorrow.Add(8 * time.Hour)},
+		{"morgen om 17:30", "nl", "morgen om 17:30", tomorrow.Add(17*time.Hour + 30*time.Minute)},
+	}
+
+	for _, f := range fixt {
+		res, err := when.Any.Parse(f.text, base)
+		require.Nil(t, err, f.text)
+		require.NotNil(t, res, f.text)
+		require.Equal(t, f.locale, res.Locale, f.text)
+		require.Equal(t, f.phrase, res.Text, f.text)
+		require.Equal(t, f.want, res.Time, f.text)
+	}
+
+	require.Equal(t, "de", when.Any.Detect("morgen um 8")[0])
+	require.Equal(t, "nl", when.Any.Detect("morgen om 8")[0])
+}
+
+func TestMultiDefault(t *testing.T) {
+	m := when.NewMulti(when.Locales["en"], when.Locales["nl"])
+
+	res, err := m.Parse("17:30", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, "en", res.Locale)
+
+	// no hints and no default, nothing is parsed
+	m.SetDefault(nil)
+	res, err = m.Parse("17:30", base)
+	require.Nil(t, err)
+	require.Nil(t, res)
+
+	m.SetDefault(when.Locales["nl"])
+	res, err = m.Parse("17:30", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, "nl", res.Locale)
+}
@@ -0,0 +1,297 @@
+package when_test
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ar"
+	"github.com/olebedev/when/rules/br"
+	"github.com/olebedev/when/rules/de"
+	"github.com/olebedev/when/rules/en"
+	"github.com/olebedev/when/rules/es"
+	"github.com/olebedev/when/rules/fr"
+	"github.com/olebedev/when/rules/ja"
+	"github.com/olebedev/when/rules/nl"
+	"github.com/olebedev/when/rules/pl"
+	"github.com/olebedev/when/rules/ru"
+	"github.com/olebedev/when/rules/uk"
+	"github.com/olebedev/when/rules/zh"
+	"github.com/stretchr/testify/require"
+)
+
+// categories are the kinds of expressions every locale is expected to
+// understand, the en feature set, along with the category of the rules
+// matching them
+var categories = []struct {
+	name     string
+	category rules.Category
+}{
+	{"weekday", rules.Date},
+	{"casual date", rules.Date},
+	{"casual time", rules.Time},
+	{"midnight", rules.Time},
+	{"hour", rules.Time},
+	{"hour minute", rules.Time},
+	{"hour relative", rules.Time},
+	{"military time", rules.Time},
+	{"deadline", rules.Duration},
+	{"past time", rules.Duration},
+	{"relative week", rules.Range},
+	{"month date", rules.Date},
+	{"date with year", rules.Date},
+	{"numeric date", rules.Date},
+}
+
+// example is a text of a category and the time it reads to from base
+type example struct {
+	text string
+	time time.Time
+}
+
+var (
+	monday       = today.AddDate(0, 0, 5)
+	march5       = time.Date(2016, 3, 5, 10, 0, 0, 0, time.UTC)
+	march5th2017 = time.Date(2017, 3, 5, 10, 0, 0, 0, time.UTC)
+)
+
+// locales holds an example of each category a locale implements, in the
+// words of the locale, it is parsed as a whole
+var locales = map[string]struct {
+	all      []rules.Rule
+	examples map[string]example
+}{
+	"en": {en.All, map[string]example{
+		"weekday":        {"next monday", monday.Add(9 * time.Hour)},
+		"casual date":    {"tomorrow", tomorrow.Add(9 * time.Hour)},
+		"casual time":    {"this evening", today.Add(19 * time.Hour)},
+		"midnight":       {"midnight", tomorrow},
+		"hour":           {"5pm", today.Add(17 * time.Hour)},
+		"hour minute":    {"5:30pm", today.Add(17*time.Hour + 30*time.Minute)},
+		"hour relative":  {"half past 2", today.Add(2*time.Hour + 30*time.Minute)},
+		"military time":  {"1430", today.Add(14*time.Hour + 30*time.Minute)},
+		"deadline":       {"in 2 hours", base.Add(2 * time.Hour)},
+		"past time":      {"5 minutes ago", base.Add(-5 * time.Minute)},
+		"relative week":  {"next week", monday.Add(9 * time.Hour)},
+		"month date":     {"march 5th", march5},
+		"date with year": {"5th may 2017", time.Date(2017, 5, 5, 10, 0, 0, 0, time.UTC)},
+		"numeric date":   {"2017-03-05", march5th2017},
+	}},
+	"ru": {ru.All, map[string]example{
+		"weekday":        {"следующий понедельник", monday.Add(10 * time.Hour)},
+		"casual date":    {"завтра", tomorrow.Add(10 * time.Hour)},
+		"casual time":    {"вечером", today.Add(18 * time.Hour)},
+		"midnight":       {"в полночь", tomorrow},
+		"hour":           {"5 вечера", today.Add(17 * time.Hour)},
+		"hour minute":    {"17:30", today.Add(17*time.Hour + 30*time.Minute)},
+		"hour relative":  {"без четверти восемь", today.Add(7*time.Hour + 45*time.Minute)},
+		"military time":  {"1430", today.Add(14*time.Hour + 30*time.Minute)},
+		"deadline":       {"через 2 часа", base.Add(2 * time.Hour)},
+		"past time":      {"5 минут назад", base.Add(-5 * time.Minute)},
+		"relative week":  {"на следующей неделе", monday.Add(9 * time.Hour)},
+		"month date":     {"5 марта", time.Date(2016, 3, 5, 0, 0, 0, 0, time.UTC)},
+		"date with year": {"5 марта 2017", time.Date(2017, 3, 5, 0, 0, 0, 0, time.UTC)},
+		"numeric date":   {"2017-03-05", march5th2017},
+	}},
+	"br": {br.All, map[string]example{
+		"weekday":        {"próxima segunda", monday.Add(10 * time.Hour)},
+		"casual date":    {"amanhã", tomorrow.Add(10 * time.Hour)},
+		"casual time":    {"de noite", today.Add(18 * time.Hour)},
+		"midnight":       {"meia-noite", tomorrow},
+		"hour":           {"às 17h", today.Add(17 * time.Hour)},
+		"hour minute":    {"17:30", today.Add(17*time.Hour + 30*time.Minute)},
+		"hour relative":  {"quinze para as oito", today.Add(7*time.Hour + 45*time.Minute)},
+		"military time":  {"1430", today.Add(14*time.Hour + 30*time.Minute)},
+		"deadline":       {"em 2 horas", base.Add(2 * time.Hour)},
+		"past time":      {"5 minutos atrás", base.Add(-5 * time.Minute)},
+		"relative week":  {"semana que vem", monday.Add(9 * time.Hour)},
+		"month date":     {"5 de março", march5},
+		"date with year": {"5 de março de 2017", march5th2017},
+		"numeric date":   {"2017-03-05", march5th2017},
+	}},
+	"nl": {nl.All, map[string]example{
+		"weekday":        {"volgende week maandag", monday.Add(10 * time.Hour)},
+		"casual date":    {"morgen", tomorrow.Add(8 * time.Hour)},
+		"casual time":    {"deze avond", today.Add(18 * time.Hour)},
+		"midnight":       {"middernacht", tomorrow},
+		"hour":           {"5 uur", today.Add(5 * time.Hour)},
+		"hour minute":    {"17:30", today.Add(17*time.Hour + 30*time.Minute)},
+		"hour relative":  {"kwart over acht", today.Add(8*time.Hour + 15*time.Minute)},
+		"military time":  {"1430", today.Add(14*time.Hour + 30*time.Minute)},
+		"deadline":       {"binnen 2 uur", base.Add(2 * time.Hour)},
+		"past time":      {"5 minuten geleden", base.Add(-5 * time.Minute)},
+		"relative week":  {"volgende week", monday.Add(9 * time.Hour)},
+		"month date":     {"5 maart", march5},
+		"date with year": {"5 maart 2017", march5th2017},
+		"numeric date":   {"2017-03-05", march5th2017},
+	}},
+	"zh": {zh.All, map[string]example{
+		"weekday":        {"下周一", monday.Add(10 * time.Hour)},
+		"casual date":    {"明天", tomorrow.Add(10 * time.Hour)},
+		"casual time":    {"晚上", today.Add(20 * time.Hour)},
+		"midnight":       {"午夜", tomorrow},
+		"hour":           {"下午3点", today.Add(15 * time.Hour)},
+		"hour minute":    {"下午3点30", today.Add(15*time.Hour + 30*time.Minute)},
+		"hour relative":  {"差一刻八点", today.Add(7*time.Hour + 45*time.Minute)},
+		"military time":  {"1430", today.Add(14*time.Hour + 30*time.Minute)},
+		"deadline":       {"5分钟后", base.Add(5 * time.Minute)},
+		"past time":      {"5分钟前", base.Add(-5 * time.Minute)},
+		"relative week":  {"下周", monday.Add(9 * time.Hour)},
+		"month date":     {"3月5日", march5},
+		"date with year": {"2017年3月5日", march5th2017},
+		"numeric date":   {"2017-03-05", march5th2017},
+	}},
+	"de": {de.All, map[string]example{
+		"weekday":        {"nächsten Montag", monday.Add(10 * time.Hour)},
+		"casual date":    {"morgen", tomorrow.Add(10 * time.Hour)},
+		"casual time":    {"am Abend", today.Add(18 * time.Hour)},
+		"midnight":       {"Mitternacht", tomorrow},
+		"hour":           {"5 Uhr", today.Add(5 * time.Hour)},
+		"hour minute":    {"17:30", today.Add(17*time.Hour + 30*time.Minute)},
+		"hour relative":  {"Viertel nach acht", today.Add(8*time.Hour + 15*time.Minute)},
+		"military time":  {"1430", today.Add(14*time.Hour + 30*time.Minute)},
+		"deadline":       {"in 2 Stunden", base.Add(2 * time.Hour)},
+		"past time":      {"vor 5 Minuten", base.Add(-5 * time.Minute)},
+		"relative week":  {"nächste Woche", monday.Add(9 * time.Hour)},
+		"month date":     {"5. März", march5},
+		"date with year": {"5. März 2017", march5th2017},
+		"numeric date":   {"2017-03-05", march5th2017},
+	}},
+	"fr": {fr.All, map[string]example{
+		"weekday":        {"lundi prochain", monday.Add(10 * time.Hour)},
+		"casual date":    {"demain", tomorrow.Add(10 * time.Hour)},
+		"casual time":    {"ce soir", today.Add(18 * time.Hour)},
+		"midnight":       {"minuit", tomorrow},
+		"hour":           {"5 heures", today.Add(5 * time.Hour)},
+		"hour minute":    {"17h30", today.Add(17*time.Hour + 30*time.Minute)},
+		"hour relative":  {"8 heures moins le quart", today.Add(7*time.Hour + 45*time.Minute)},
+		"military time":  {"1430", today.Add(14*time.Hour + 30*time.Minute)},
+		"deadline":       {"dans 2 heures", base.Add(2 * time.Hour)},
+		"past time":      {"il y a 5 minutes", base.Add(-5 * time.Minute)},
+		"relative week":  {"semaine prochaine", monday.Add(9 * time.Hour)},
+		"month date":     {"5 mars", march5},
+		"date with year": {"5 mars 2017", march5th2017},
+		"numeric date":   {"2017-03-05", march5th2017},
+	}},
+	"es": {es.All, map[string]example{
+		"weekday":        {"próximo lunes", monday.Add(10 * time.Hour)},
+		"casual date":    {"mañana", tomorrow.Add(10 * time.Hour)},
+		"casual time":    {"por la tarde", today.Add(15 * time.Hour)},
+		"midnight":       {"medianoche", tomorrow},
+		"hour":           {"a las 5 de la tarde", today.Add(17 * time.Hour)},
+		"hour minute":    {"17:30", today.Add(17*time.Hour + 30*time.Minute)},
+		"hour relative":  {"a las 8 menos cuarto", today.Add(7*time.Hour + 45*time.Minute)},
+		"military time":  {"1430", today.Add(14*time.Hour + 30*time.Minute)},
+		"deadline":       {"en 2 horas", base.Add(2 * time.Hour)},
+		"past time":      {"hace 5 minutos", base.Add(-5 * time.Minute)},
+		"relative week":  {"semana que viene", monday.Add(9 * time.Hour)},
+		"month date":     {"5 de marzo", march5},
+		"date with year": {"5 de marzo de 2017", march5th2017},
+		"numeric date":   {"2017-03-05", march5th2017},
+	}},
+	"ja": {ja.All, map[string]example{
+		"weekday":        {"来週の月曜日", monday.Add(10 * time.Hour)},
+		"casual date":    {"明日", tomorrow.Add(10 * time.Hour)},
+		"casual time":    {"今夜", today.Add(20 * time.Hour)},
+		"midnight":       {"真夜中", tomorrow},
+		"hour":           {"午後5時", today.Add(17 * time.Hour)},
+		"hour minute":    {"17時30分", today.Add(17*time.Hour + 30*time.Minute)},
+		"military time":  {"1430", today.Add(14*time.Hour + 30*time.Minute)},
+		"deadline":       {"2時間後", base.Add(2 * time.Hour)},
+		"past time":      {"5分前", base.Add(-5 * time.Minute)},
+		"relative week":  {"来週", monday.Add(9 * time.Hour)},
+		"month date":     {"3月5日", march5},
+		"date with year": {"2017年3月5日", march5th2017},
+		"numeric date":   {"2017-03-05", march5th2017},
+	}},
+	"uk": {uk.All, map[string]example{
+		"weekday":        {"наступного понеділка", monday.Add(10 * time.Hour)},
+		"casual date":    {"завтра", tomorrow.Add(10 * time.Hour)},
+		"casual time":    {"ввечері", today.Add(18 * time.Hour)},
+		"midnight":       {"опівночі", tomorrow},
+		"hour":           {"5 вечора", today.Add(17 * time.Hour)},
+		"hour minute":    {"17:30", today.Add(17*time.Hour + 30*time.Minute)},
+		"hour relative":  {"за чверть восьма", today.Add(7*time.Hour + 45*time.Minute)},
+		"military time":  {"1430", today.Add(14*time.Hour + 30*time.Minute)},
+		"deadline":       {"через 2 години", base.Add(2 * time.Hour)},
+		"past time":      {"5 хвилин тому", base.Add(-5 * time.Minute)},
+		"relative week":  {"наступного тижня", monday.Add(9 * time.Hour)},
+		"month date":     {"5 березня", march5},
+		"date with year": {"5 березня 2017", march5th2017},
+		"numeric date":   {"2017-03-05", march5th2017},
+	}},
+	"pl": {pl.All, map[string]example{
+		"weekday":        {"w następny poniedziałek", monday.Add(10 * time.Hour)},
+		"casual date":    {"jutro", tomorrow.Add(10 * time.Hour)},
+		"casual time":    {"wieczorem", today.Add(18 * time.Hour)},
+		"midnight":       {"o północy", tomorrow},
+		"hour":           {"o 5 po południu", today.Add(17 * time.Hour)},
+		"hour minute":    {"17:30", today.Add(17*time.Hour + 30*time.Minute)},
+		"hour relative":  {"za kwadrans ósma", today.Add(7*time.Hour + 45*time.Minute)},
+		"military time":  {"1430", today.Add(14*time.Hour + 30*time.Minute)},
+		"deadline":       {"za 2 godziny", base.Add(2 * time.Hour)},
+		"past time":      {"5 minut temu", base.Add(-5 * time.Minute)},
+		"relative week":  {"w przyszłym tygodniu", monday.Add(9 * time.Hour)},
+		"month date":     {"5 marca", march5},
+		"date with year": {"5 marca 2017", march5th2017},
+		"numeric date":   {"2017-03-05", march5th2017},
+	}},
+	"ar": {ar.All, map[string]example{
+		"weekday":        {"الاثنين القادم", monday.Add(10 * time.Hour)},
+		"casual date":    {"غداً", tomorrow.Add(10 * time.Hour)},
+		"casual time":    {"مساءً", today.Add(18 * time.Hour)},
+		"midnight":       {"منتصف الليل", tomorrow},
+		"hour":           {"5 مساءً", today.Add(17 * time.Hour)},
+		"hour minute":    {"17:30", today.Add(17*time.Hour + 30*time.Minute)},
+		"hour relative":  {"الثامنة إلا ربعاً", today.Add(7*time.Hour + 45*time.Minute)},
+		"military time":  {"1430", today.Add(14*time.Hour + 30*time.Minute)},
+		"deadline":       {"بعد ساعتين", base.Add(2 * time.Hour)},
+		"past time":      {"منذ 5 دقائق", base.Add(-5 * time.Minute)},
+		"relative week":  {"الأسبوع القادم", monday.Add(9 * time.Hour)},
+		"month date":     {"5 مارس", march5},
+		"date with year": {"5 مارس 2017", march5th2017},
+		"numeric date":   {"2017-03-05", march5th2017},
+	}},
+}
+
+// gaps lists the categories a locale does not implement on purpose
+var gaps = map[string][]string{
+	// "8時10分前" reads as ten minutes before 8:00 and as ten minutes ago
+	// at 8:00 alike, the past time wins
+	"ja": {"hour relative"},
+}
+
+func TestLocaleParity(t *testing.T) {
+	names := make([]string, 0, len(locales))
+	for name := range locales {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		l := locales[name]
+		p := newParser(nil, l.all)
+
+		for _, c := range categories {
+			example, ok := l.examples[c.name]
+			if !ok {
+				require.Contains(t, gaps[name], c.name, "[%s] %s is neither implemented nor a known gap", name, c.name)
+				continue
+			}
+			require.NotContains(t, gaps[name], c.name, "[%s] %s is implemented, drop the gap", name, c.name)
+
+			res, err := p.Parse(example.text, base)
+			require.Nil(t, err, "[%s] %s: %s", name, c.name, example.text)
+			require.NotNil(t, res, "[%s] %s: %s", name, c.name, example.text)
+			require.Equal(t, example.text, res.Text, "[%s] %s: %s", name, c.name, example.text)
+			require.Equal(t, example.time, res.Time, "[%s] %s: %s", name, c.name, example.text)
+
+			matched := make([]rules.Category, 0, len(res.Parts))
+			for _, part := range res.Parts {
+				matched = append(matched, part.Category)
+			}
+			require.Contains(t, matched, c.category, "[%s] %s: %s", name, c.name, example.text)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package when
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/olebedev/when/rules"
+)
+
+// Part is a match of a rule a result is made of, like "next friday" and
+// "5pm" of "next friday at 5pm"
+type Part struct {
+	// Rule is the rules.Meta name of the rule if it has one, Category is
+	// the kind of the expressions it matches
+	Rule     string
+	Category rules.Category
+	// Index is a start index in Result.Source, in bytes
+	Index int
+	// Text is the match as it is in Result.Source, Start and End are its
+	// borders
+	Text       string
+	Start, End Position
+	// Captures are the groups of the match as they are in Result.Source,
+	// the rule got them after the middlewares
+	Captures []string
+	// Fields are the names of the fields of the context the result took
+	// from the match, like "Weekday" or "Hour", see rules.Match
+	Fields []string
+}
+
+// part returns the part of the match in the source, the offsets of the
+// middlewares lead back to it. The text is trimmed to the words matched,
+// the spaces a rule takes around them left out.
+func part(m *rules.Match, meta rules.Meta, offsets []rules.Offsets, source string) Part {
+	left, right := back(m.Left, m.Right, offsets)
+	for left < right {
+		r, n := utf8.DecodeRuneInString(source[left:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		left += n
+	}
+	for left < right {
+		r, n := utf8.DecodeLastRuneInString(source[:right])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		right -= n
+	}
+
+	captures := m.Captures
+	if len(m.Bounds) == len(m.Captures) {
+		captures = make([]string, len(m.Bounds))
+		for i, b := range m.Bounds {
+			if b[0] >= 0 {
+				l, r := back(b[0], b[1], offsets)
+				captures[i] = source[l:r]
+			}
+		}
+	}
+
+	return Part{
+		Rule:     meta.Name,
+		Category: meta.Category,
+		Index:    left,
+		Text:     source[left:right],
+		Start:    position(source, left),
+		End:      position(source, right),
+		Captures: captures,
+	}
+}
+
+// back maps the borders in the text the rules got to the source through
+// the offsets of the middlewares
+func back(left, right int, offsets []rules.Offsets) (int, int) {
+	for i := len(offsets) - 1; i >= 0; i-- {
+		left, right = offsets[i].Map(left), offsets[i].Map(right)
+	}
+	return left, right
+}
+
+// parts returns the parts of the matches in the order of the text, the
+// matches go in the order they were applied in. A field set again by a
+// later match belongs to that one, only the durations merged add up.
+func parts(applied []*rules.Match, metas map[*rules.Match]rules.Meta, offsets []rules.Offsets, source string) []Part {
+	list := make([]Part, 0, len(applied))
+	for i, m := range applied {
+		p := part(m, metas[m], offsets, source)
+		for _, f := range m.Fields {
+			if !setAgain(f, applied[i+1:]) {
+				p.Fields = append(p.Fields, f)
+			}
+		}
+		list = append(list, p)
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Index < list[j].Index
+	})
+	return list
+}
+
+// setAgain tells if any of the matches set the field over the value of an
+// earlier one
+func setAgain(field string, matches []*rules.Match) bool {
+	for _, m := range matches {
+		if field == "Duration" && m.Strategy == rules.Merge {
+			continue
+		}
+		for _, f := range m.Fields {
+			if f == field {
+				return true
+			}
+		}
+	}
+	return false
+}
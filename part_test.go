@@ -0,0 +1,103 @@
+package when_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/en"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParts(t *testing.T) {
+	res, err := when.EN.Parse("next friday at 5pm", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, []when.Part{
+		{
+			Rule:     "en.weekday",
+			Category: rules.Date,
+			Index:    0,
+			Text:     "next friday",
+			Start:    when.Position{Byte: 0, Rune: 0, UTF16: 0},
+			End:      when.Position{Byte: 11, Rune: 11, UTF16: 11},
+			Captures: []string{"next", "friday", ""},
+			Fields:   []string{"Duration", "Weekday"},
+		},
+		{
+			Rule:     "en.hour",
+			Category: rules.Time,
+			Index:    15,
+			Text:     "5pm",
+			Start:    when.Position{Byte: 15, Rune: 15, UTF16: 15},
+			End:      when.Position{Byte: 18, Rune: 18, UTF16: 18},
+			Captures: []string{"5", "pm", ""},
+			Fields:   []string{"Hour", "Minute", "Second"},
+		},
+	}, res.Parts)
+
+	// the time said takes the fields of the default one, even the same
+	for _, text := range []string{"next friday at 9am", "tomorrow at 9:00"} {
+		res, err = when.EN.Parse(text, base)
+		require.Nil(t, err, text)
+		require.NotNil(t, res, text)
+		require.Len(t, res.Parts, 2, text)
+		require.NotContains(t, res.Parts[0].Fields, "Hour", text)
+		require.NotContains(t, res.Parts[0].Fields, "Minute", text)
+		require.Equal(t, []string{"Hour", "Minute", "Second"}, res.Parts[1].Fields, text)
+	}
+
+	// in the order of the text, not the one they were applied in
+	res, err = when.EN.Parse("call me at 10:30 tomorrow", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Len(t, res.Parts, 2)
+	require.Equal(t, "10:30", res.Parts[0].Text)
+	require.Equal(t, rules.Time, res.Parts[0].Category)
+	require.Equal(t, "tomorrow", res.Parts[1].Text)
+	require.Equal(t, rules.Date, res.Parts[1].Category)
+}
+
+func TestPartsSource(t *testing.T) {
+	// the parts and their captures point into the source, not the
+	// normalized text the rules got
+	res, err := when.AR.Parse("غدا الساعة ٥ مساءً", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Len(t, res.Parts, 2)
+
+	p := res.Parts[1]
+	require.Equal(t, "ar.hour", p.Rule)
+	require.Equal(t, "الساعة ٥ مساءً", p.Text)
+	require.Equal(t, p.Text, res.Source[p.Index:p.End.Byte])
+	require.Equal(t, when.Position{Byte: 7, Rune: 4, UTF16: 4}, p.Start)
+	require.Equal(t, "٥", p.Captures[1])
+}
+
+func TestPartsRewritten(t *testing.T) {
+	w := when.New(nil)
+	w.Add(en.All...)
+	w.Use(func(text string) (string, error) {
+		return strings.Replace(text, "tmrw", "tomorrow", 1), nil
+	})
+
+	// the captures are the words of the source the rewritten ones stand
+	// for, the text leaves out the spaces the rule took after them
+	res, err := w.Parse("see you tmrw  at 5pm", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Len(t, res.Parts, 2)
+
+	p := res.Parts[0]
+	require.Equal(t, "en.casual_date", p.Rule)
+	require.Equal(t, "tmrw", p.Text)
+	require.Equal(t, p.Text, res.Source[p.Index:p.End.Byte])
+	require.Equal(t, []string{"tmrw "}, p.Captures)
+
+	p = res.Parts[1]
+	require.Equal(t, "5pm", p.Text)
+	require.Equal(t, []string{"5", "pm", ""}, p.Captures)
+	require.Equal(t, tomorrow.Add(17*time.Hour), res.Time)
+}
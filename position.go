@@ -0,0 +1,21 @@
+package when
+
+// Position is an offset in a text counted in bytes, in runes and in the
+// UTF-16 code units JavaScript strings are indexed by
+type Position struct {
+	Byte, Rune, UTF16 int
+}
+
+// position returns the position of the byte offset i in the text
+func position(text string, i int) Position {
+	pos := Position{Byte: i}
+	for _, r := range text[:i] {
+		pos.Rune++
+		pos.UTF16++
+		if r > 0xFFFF {
+			// a surrogate pair
+			pos.UTF16++
+		}
+	}
+	return pos
+}
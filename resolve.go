@@ -0,0 +1,156 @@
+package when
+
+import (
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"github.com/olebedev/when/rules"
+)
+
+// candidate is a match of a rule competing for its part of the text
+type candidate struct {
+	*rules.Match
+	// priority is the one of the rule
+	priority int
+}
+
+// specificity is the number of the captures the match has
+func (c candidate) specificity() int {
+	n := 0
+	for _, s := range c.Captures {
+		if s != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// better tells if the candidate wins over the other one overlapping it:
+// the longer match in runes wins, then the one having more captures, then
+// the one starting first, then the one of the rule of a higher priority,
+// then the one of the rule checked first
+func (c candidate) better(o candidate) bool {
+	if a, b := utf8.RuneCountInString(c.Text), utf8.RuneCountInString(o.Text); a != b {
+		return a > b
+	}
+	if a, b := c.specificity(), o.specificity(); a != b {
+		return a > b
+	}
+	if c.Left != o.Left {
+		return c.Left < o.Left
+	}
+	if c.priority != o.priority {
+		return c.priority > o.priority
+	}
+	return c.Order < o.Order
+}
+
+// viable drops the candidates the rules refuse with nothing read before
+// them, like the day "1430" of "1430 march 5th", so they don't take the text
+// of the ones they overlap. The matches of the rule inside a refused one go
+// too, like "Morgen" of "Guten Morgen".
+func viable(candidates []candidate, o *rules.Options, base time.Time, trace *Trace) []candidate {
+	var refused []candidate
+	for _, c := range candidates {
+		if ok, err := c.Apply(&rules.Context{}, o, base); !ok && err == nil {
+			refused = append(refused, c)
+		}
+	}
+
+	kept := candidates[:0]
+	for _, c := range candidates {
+		inside := false
+		for _, r := range refused {
+			if r.Order == c.Order && r.Left <= c.Left && c.Right <= r.Right {
+				inside = true
+				break
+			}
+		}
+		if inside {
+			trace.refuse(c.Match)
+			continue
+		}
+		kept = append(kept, c)
+	}
+	return kept
+}
+
+// unstopped drops the candidates overlapping the stops of the parser, like
+// the "1200" of "room 1200"
+func unstopped(candidates []candidate, stops rules.Stops, trace *Trace) []candidate {
+	if len(stops) == 0 {
+		return candidates
+	}
+	kept := candidates[:0]
+	for _, c := range candidates {
+		if stops.Cover(c.Match) {
+			trace.refuse(c.Match)
+			continue
+		}
+		kept = append(kept, c)
+	}
+	return kept
+}
+
+// overlaps tells if the matches share any part of the text
+func overlaps(a, b *rules.Match) bool {
+	return a.Left < b.Right && b.Left < a.Right
+}
+
+// resolve drops the candidates overlapping a better one and returns the
+// rest sorted by index
+func resolve(candidates []candidate) []*rules.Match {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].better(candidates[j])
+	})
+
+	matches := make([]*rules.Match, 0, len(candidates))
+	for _, c := range candidates {
+		free := true
+		for _, m := range matches {
+			if overlaps(c.Match, m) {
+				free = false
+				break
+			}
+		}
+		if free {
+			matches = append(matches, c.Match)
+		}
+	}
+
+	sort.Stable(rules.MatchByIndex(matches))
+	return matches
+}
+
+// firstOfRule keeps the first match of each rule out of the matches sorted
+// by index, so a rule is applied once
+func firstOfRule(matches []*rules.Match) []*rules.Match {
+	seen := make(map[float64]bool, len(matches))
+	kept := matches[:0]
+	for _, m := range matches {
+		if !seen[m.Order] {
+			seen[m.Order] = true
+			kept = append(kept, m)
+		}
+	}
+	return kept
+}
+
+// span returns the borders of the match along with the candidates
+// overlapping it: they lost the text to the match but it is a part of the
+// expression still, like "неделе" of "в понедельник на следующей неделе"
+func span(m *rules.Match, candidates []candidate) (int, int) {
+	left, right := m.Left, m.Right
+	for _, c := range candidates {
+		if overlaps(c.Match, m) {
+			if c.Left < left {
+				left = c.Left
+			}
+			if c.Right > right {
+				right = c.Right
+			}
+		}
+	}
+	return left, right
+}
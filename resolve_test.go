@@ -0,0 +1,89 @@
+package when_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ar"
+	"github.com/olebedev/when/rules/common"
+	"github.com/olebedev/when/rules/en"
+	"github.com/olebedev/when/rules/fr"
+	"github.com/olebedev/when/rules/ru"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverlaps(t *testing.T) {
+	fixt := []struct {
+		all  []rules.Rule
+		text string
+		want string
+		time time.Time
+	}{
+		// "2017" is not the military time of 20:17
+		{en.All, "5th may 2017 at 5pm", "5th may 2017 at 5pm", time.Date(2017, 5, 5, 17, 0, 0, 0, time.UTC)},
+		// the first match of the military time is taken by the date, the
+		// next one counts
+		{en.All, "5th may 2017 at 0800", "5th may 2017 at 0800", time.Date(2017, 5, 5, 8, 0, 0, 0, time.UTC)},
+		// "10 pm" is a part of "11:10 pm"
+		{en.All, "tonight at 11:10 pm", "tonight at 11:10 pm", today.Add(23*time.Hour + 10*time.Minute)},
+		// "2 heures" is a duration, not the hour
+		{fr.All, "dans 2 heures", "dans 2 heures", base.Add(2 * time.Hour)},
+		// a rule is applied once
+		{en.All, "from 5pm to 7pm", "5pm", today.Add(17 * time.Hour)},
+		// the overlapped matches are a part of the text still
+		{ru.All, "в понедельник на следующей неделе", "в понедельник на следующей неделе", today.AddDate(0, 0, 5).Add(10 * time.Hour)},
+		// the loser keeps the words not overlapping the winner, the
+		// match of the same length starting first wins
+		{en.All, "half past 2 march 5th", "half past 2 march 5th", time.Date(2016, 3, 5, 2, 30, 0, 0, time.UTC)},
+		{append(en.All, common.All...), "2017-03-05 5:30pm", "2017-03-05 5:30pm", time.Date(2017, 3, 5, 17, 30, 0, 0, time.UTC)},
+		// the length counts in runes, not bytes
+		{append(ar.All, common.All...), "2017-03-05 مساءً", "2017-03-05 مساءً", time.Date(2017, 3, 5, 18, 0, 0, 0, time.UTC)},
+	}
+
+	for _, f := range fixt {
+		w := newParser(nil, f.all)
+		res, err := w.Parse(f.text, base)
+		require.Nil(t, err, f.text)
+		require.NotNil(t, res, f.text)
+		require.Equal(t, f.want, res.Text, f.text)
+		require.Equal(t, f.time, res.Time, f.text)
+	}
+}
+
+func TestOverlapsTrace(t *testing.T) {
+	w := newParser(nil, en.All)
+
+	_, trace, err := w.ParseWithTrace("5th may 2017 at 5pm", base)
+	require.Nil(t, err)
+
+	state := make(map[string]bool)
+	refused := make(map[string]bool)
+	for _, m := range trace.Matches {
+		require.False(t, m.Overlapped && m.Clustered, m.Name)
+		state[m.Name] = m.Overlapped
+		refused[m.Name] = m.Refused
+	}
+	require.Equal(t, map[string]bool{
+		"en.day_month_year":   false,
+		"en.month_year":       true,
+		"en.exact_month_date": false,
+		"en.hour":             false,
+		"en.military_time":    true,
+	}, state)
+	// the year is no day, the rule refuses it on its own
+	require.True(t, refused["en.exact_month_date"])
+	require.Len(t, trace.Applied, 2)
+	require.Contains(t, trace.String(), "overlapped")
+}
+
+func TestOverlapsTraceOnce(t *testing.T) {
+	w := newParser(nil, en.All)
+
+	_, trace, err := w.ParseWithTrace("from 5pm to 7pm", base)
+	require.Nil(t, err)
+	require.Len(t, trace.Matches, 2)
+	require.True(t, trace.Matches[0].Clustered)
+	require.False(t, trace.Matches[1].Overlapped)
+	require.False(t, trace.Matches[1].Clustered)
+}
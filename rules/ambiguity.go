@@ -0,0 +1,57 @@
+package rules
+
+// Ambiguity is a reading of a match that could have been read otherwise,
+// like "at 7" read as 7am rather than 7pm
+type Ambiguity struct {
+	// Label names the assumption of the reading, like "am"
+	Label string
+	// Confidence is the likelihood of the reading, from 0 to 1
+	Confidence float64
+	// Alternatives are the other readings of the match
+	Alternatives []Alternative
+}
+
+// Alternative is another reading of an ambiguous match
+type Alternative struct {
+	// Label names the assumption of the reading, like "pm"
+	Label string
+	// Confidence is the likelihood of the reading, from 0 to 1
+	Confidence float64
+	// Apply changes the context of the reading of the rule to the one of
+	// the alternative
+	Apply func(*Context)
+}
+
+// Ambiguous tells the rule read the match by the assumption of the label
+// with the confidence and offers the other readings of it
+func (c *Context) Ambiguous(label string, confidence float64, alternatives ...Alternative) {
+	c.Ambiguities = append(c.Ambiguities, Ambiguity{
+		Label:        label,
+		Confidence:   confidence,
+		Alternatives: alternatives,
+	})
+}
+
+// AmbiguousClock tells the rule read the hour of a 12-hour clock said
+// without am or pm, like "7 o'clock", as the morning one from 1 to 11 or
+// the evening one from 13 to 23 and offers the other one. The other hours
+// are not ambiguous.
+func (c *Context) AmbiguousClock(hour int) {
+	label, other, shift := "am", "pm", 12
+	switch {
+	case hour >= 13 && hour <= 23:
+		label, other, shift = "pm", "am", -12
+	case hour < 1 || hour > 11:
+		return
+	}
+	c.Ambiguous(label, 0.6, Alternative{
+		Label:      other,
+		Confidence: 0.4,
+		Apply: func(c *Context) {
+			if c.Hour != nil && *c.Hour == hour {
+				h := hour + shift
+				c.Hour = &h
+			}
+		},
+	})
+}
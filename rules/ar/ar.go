@@ -0,0 +1,361 @@
+// Package ar holds the rules of the Arabic language. The rules match the
+// ASCII digits only, the parser normalizes the Arabic-Indic ones with
+// rules.Digits. The letters written interchangeably, like the forms of
+// alef or the final ya and ta marbuta, and the diacritics don't matter.
+package ar
+
+import (
+	_ "embed"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/olebedev/when/rules/locale"
+	"github.com/olebedev/when/rules/romance"
+)
+
+var describe = rules.Describer("ar")
+
+//go:embed ar.json
+var vocabulary []byte
+
+// DATA holds the words of the language, see Extend
+var DATA = locale.MustLoad(vocabulary)
+
+var All []rules.Rule
+
+func init() {
+	build()
+}
+
+// Extend adds the words of ext to the language and rebuilds All, the
+// parsers made before keep the rules they have. Call it before making the
+// parsers, it isn't safe for concurrent use.
+func Extend(ext *locale.Data) {
+	DATA.Extend(ext)
+	build()
+}
+
+// build makes the rules from the tables
+func build() {
+	All = []rules.Rule{
+		describe("weekday", rules.Date, 70, Weekday(rules.Override)),
+		describe("relative_week", rules.Range, 70, RelativeWeek(rules.Override)),
+		describe("casual_date", rules.Date, 60, CasualDate(rules.Override)),
+		describe("casual_time", rules.Time, 60, CasualTime(rules.Override)),
+		describe("midnight", rules.Time, 60, Midnight(rules.Override)),
+		describe("military_time", rules.Time, 50, common.MilitaryTime(rules.Override)),
+		describe("hour", rules.Time, 50, Hour(rules.Override)),
+		describe("hour_minute", rules.Time, 50, HourMinute(rules.Override)),
+		describe("hour_relative", rules.Time, 50, HourRelative(rules.Override)),
+		describe("deadline", rules.Duration, 40, Deadline(rules.Override)),
+		describe("past_time", rules.Duration, 40, PastTime(rules.Override)),
+		describe("month_date", rules.Date, 30, MonthDate(rules.Override)),
+	}
+}
+
+// CONNECTORS holds the words joining the parts of a time, like "في" of
+// "غدا في الساعة 3", see rules.ClusterByTokens
+var CONNECTORS = []string{"في", "على", "عند", "يوم"}
+
+// LABELS are the words labeling the numbers of the things, like "غرفة" of
+// "غرفة 1200", see STOPS
+var LABELS = []string{
+	"رقم", "غرفة", "الإصدار", "رحلة", "صفحة",
+}
+
+// STOPS keep the numbers of the things from being read as a time or a date,
+// like "غرفة 1200" or "الإصدار 2.0", see Parser.Stop of the when package
+var STOPS = []*regexp.Regexp{
+	rules.Labeled(LABELS...),
+}
+
+// the tables of the language, from ar.json
+var (
+	WEEKDAY_OFFSET = DATA.Weekdays
+	// MONTH_OFFSET holds both the names of the months used in Egypt and
+	// the Gulf and the Syriac ones used in the Levant and Iraq
+	MONTH_OFFSET = DATA.Months
+)
+
+// SHIFTS holds the words following a weekday or a period, in both genders
+var SHIFTS = map[string]int{
+	"القادم":  1,
+	"القادمة": 1,
+	"المقبل":  1,
+	"المقبلة": 1,
+	"التالي":  1,
+	"التالية": 1,
+	"الجاي":   1,
+	"الجاية":  1,
+	"الماضي":  -1,
+	"الماضية": -1,
+	"الفائت":  -1,
+	"الفائتة": -1,
+	"السابق":  -1,
+	"السابقة": -1,
+}
+
+// HOUR_ORDINALS holds the feminine ordinals naming the hours, "الساعة
+// الخامسة"
+var HOUR_ORDINALS = map[string]int{
+	"الواحدة":      1,
+	"الأولى":       1,
+	"الثانية":      2,
+	"الثالثة":      3,
+	"الرابعة":      4,
+	"الخامسة":      5,
+	"السادسة":      6,
+	"السابعة":      7,
+	"الثامنة":      8,
+	"التاسعة":      9,
+	"العاشرة":      10,
+	"الحادية عشرة": 11,
+	"الثانية عشرة": 12,
+}
+
+var DAY_PARTS = map[string]common.DayPart{
+	"صباحا":     common.Morning,
+	"صباحاً":    common.Morning,
+	"في الصباح": common.Morning,
+	"ظهرا":      common.Noon,
+	"ظهراً":     common.Noon,
+	"عصرا":      common.Afternoon,
+	"عصراً":     common.Afternoon,
+	"بعد الظهر": common.Afternoon,
+	"مساء":      common.Evening,
+	"مساءً":     common.Evening,
+	"في المساء": common.Evening,
+	"ليلا":      common.Night,
+	"ليلاً":     common.Night,
+	"في الليل":  common.Night,
+	"بالليل":    common.Night,
+}
+
+// AMOUNTS holds the spelled-out amounts of the relative expressions
+var AMOUNTS = map[string]float64{
+	"واحد":   1,
+	"واحدة":  1,
+	"اثنين":  2,
+	"اثنتين": 2,
+	"ثلاث":   3,
+	"ثلاثة":  3,
+	"أربع":   4,
+	"أربعة":  4,
+	"خمس":    5,
+	"خمسة":   5,
+	"ست":     6,
+	"ستة":    6,
+	"سبع":    7,
+	"سبعة":   7,
+	"ثماني":  8,
+	"ثمانية": 8,
+	"تسع":    9,
+	"تسعة":   9,
+	"عشر":    10,
+	"عشرة":   10,
+	"بضع":    3,
+	"بضعة":   3,
+	"عدة":    3,
+	"نصف":    0.5,
+	"ربع":    0.25,
+}
+
+// UNITS holds all the forms of the units of the relative expressions, a
+// unit on its own is one of it, "بعد ساعة"
+var UNITS = map[string]romance.Unit{
+	"ثانية":  romance.Seconds,
+	"ثوان":   romance.Seconds,
+	"ثواني":  romance.Seconds,
+	"دقيقة":  romance.Minutes,
+	"دقائق":  romance.Minutes,
+	"دقايق":  romance.Minutes,
+	"ساعة":   romance.Hours,
+	"ساعات":  romance.Hours,
+	"يوم":    romance.Days,
+	"يوما":   romance.Days,
+	"أيام":   romance.Days,
+	"أسبوع":  romance.Weeks,
+	"أسبوعا": romance.Weeks,
+	"أسابيع": romance.Weeks,
+	"شهر":    romance.Months,
+	"شهرا":   romance.Months,
+	"أشهر":   romance.Months,
+	"شهور":   romance.Months,
+	"سنة":    romance.Years,
+	"سنوات":  romance.Years,
+	"سنين":   romance.Years,
+	"عام":    romance.Years,
+	"عاما":   romance.Years,
+	"أعوام":  romance.Years,
+}
+
+// DUALS holds the dual forms of the units, which are two of them on their
+// own, "بعد يومين"
+var DUALS = map[string]romance.Unit{
+	"ثانيتين": romance.Seconds,
+	"ثانيتان": romance.Seconds,
+	"دقيقتين": romance.Minutes,
+	"دقيقتان": romance.Minutes,
+	"ساعتين":  romance.Hours,
+	"ساعتان":  romance.Hours,
+	"يومين":   romance.Days,
+	"يومان":   romance.Days,
+	"أسبوعين": romance.Weeks,
+	"أسبوعان": romance.Weeks,
+	"شهرين":   romance.Months,
+	"شهران":   romance.Months,
+	"سنتين":   romance.Years,
+	"سنتان":   romance.Years,
+	"عامين":   romance.Years,
+	"عامان":   romance.Years,
+}
+
+// PERIODS holds the periods of the relative weeks, "الأسبوع القادم"
+var PERIODS = map[string]common.Period{
+	"الأسبوع": common.Week,
+	"الشهر":   common.Month,
+	"السنة":   common.Year,
+	"العام":   common.Year,
+}
+
+// normalize unifies the spellings of a word: the forms of alef, the final
+// ya and ta marbuta and the spaces, and drops the diacritics and tatweel
+func normalize(word string) string {
+	word = strings.Join(strings.Fields(word), " ")
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case 'أ', 'إ', 'آ', 'ٱ':
+			return 'ا'
+		case 'ى':
+			return 'ي'
+		case 'ة':
+			return 'ه'
+		case 'ـ':
+			return -1
+		}
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+		return r
+	}, word)
+}
+
+// spellings holds the letters normalize unifies with the ones they stand
+// for
+var spellings = map[rune]string{
+	'ا': "[اأإآٱ]",
+	'ي': "[يى]",
+	'ه': "[هة]",
+}
+
+// words returns the pattern matching any of the words in any of their
+// spellings, the longest ones first
+func words(list ...string) string {
+	seen := make(map[string]bool, len(list))
+	var norm []string
+	for _, w := range list {
+		if w = normalize(w); w != "" && !seen[w] {
+			seen[w] = true
+			norm = append(norm, w)
+		}
+	}
+	if len(norm) == 0 {
+		// never matches
+		return "(?:\\b\\B)"
+	}
+	sort.Slice(norm, func(i, j int) bool {
+		if len(norm[i]) != len(norm[j]) {
+			return len(norm[i]) > len(norm[j])
+		}
+		return norm[i] < norm[j]
+	})
+
+	var b strings.Builder
+	b.WriteString("(?:")
+	for i, w := range norm {
+		if i > 0 {
+			b.WriteByte('|')
+		}
+		for _, r := range w {
+			switch {
+			case r == ' ':
+				b.WriteString("\\s+")
+			case spellings[r] != "":
+				b.WriteString(spellings[r])
+			default:
+				b.WriteString(regexp.QuoteMeta(string(r)))
+			}
+			if unicode.IsLetter(r) {
+				b.WriteString("[\\p{Mn}ـ]*")
+			}
+		}
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+// keys returns the words of a table
+func keys(table map[string]int) []string {
+	list := make([]string, 0, len(table))
+	for k := range table {
+		list = append(list, k)
+	}
+	return list
+}
+
+// lookup returns the value of a word in any of its spellings
+func lookup(table map[string]int, word string) (int, bool) {
+	word = normalize(word)
+	for k, v := range table {
+		if normalize(k) == word {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// dayPart returns the part of the day of a word in any of its spellings
+func dayPart(word string) (common.DayPart, bool) {
+	word = normalize(word)
+	for k, p := range DAY_PARTS {
+		if normalize(k) == word {
+			return p, true
+		}
+	}
+	return 0, false
+}
+
+// dayParts returns the pattern of the parts of the day
+func dayParts() string {
+	list := make([]string, 0, len(DAY_PARTS))
+	for k := range DAY_PARTS {
+		list = append(list, k)
+	}
+	return words(list...)
+}
+
+// hour converts a digit or ordinal hour to a 24-hour clock with the part
+// of the day following it, if any
+func hour(number, part string, o *rules.Options) (int, bool) {
+	h, ok := lookup(HOUR_ORDINALS, number)
+	if !ok {
+		var err error
+		if h, err = strconv.Atoi(number); err != nil {
+			return 0, false
+		}
+	}
+
+	if part != "" {
+		p, ok := dayPart(part)
+		if !ok || h > 12 {
+			return 0, false
+		}
+		return p.Clock(h, o), true
+	}
+	return h, h < 24
+}
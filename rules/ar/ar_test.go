@@ -0,0 +1,60 @@
+package ar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ar"
+	"github.com/stretchr/testify/require"
+)
+
+var null = time.Date(2016, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+type Fixture struct {
+	Text   string
+	Index  int
+	Phrase string
+	Diff   time.Duration
+}
+
+func ApplyFixtures(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d - %s", name, i, f.Text)
+		require.NotNil(t, res, "[%s] res #%d - %s", name, i, f.Text)
+		require.Equal(t, f.Index, res.Index, "[%s] index #%d - %s", name, i, f.Text)
+		require.Equal(t, f.Phrase, res.Text, "[%s] text #%d - %s", name, i, f.Text)
+		require.Equal(t, f.Diff, res.Time.Sub(null), "[%s] diff #%d - %s", name, i, f.Text)
+	}
+}
+
+func ApplyFixturesNil(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d", name, i)
+		require.Nil(t, res, "[%s] res #%d", name, i)
+	}
+}
+
+func TestAll(t *testing.T) {
+	w := when.New(nil)
+	w.Normalize(rules.Digits)
+	w.Add(ar.All...)
+
+	// complex cases
+	fixt := []Fixture{
+		{"غداً الساعة ٩", 0, "غداً الساعة ٩", (24 + 9) * time.Hour},
+		{"ذكرني بعد ٣ أيام", 11, "بعد ٣ أيام", 3 * 24 * time.Hour},
+		{"نلتقي يوم الجمعة", 11, "يوم الجمعة", 2 * 24 * time.Hour},
+		{"يوم الجمعة الساعة ١٨:٣٠", 0, "يوم الجمعة الساعة ١٨:٣٠", (2*24+18)*time.Hour + 30*time.Minute},
+		{"الاثنين القادم مساءً", 0, "الاثنين القادم مساءً", (5*24 + 18) * time.Hour},
+		{"بعد غد الساعة التاسعة صباحاً", 0, "بعد غد الساعة التاسعة صباحاً", (2*24 + 9) * time.Hour},
+		{"غدا الساعة السابعة والنصف مساء", 0, "غدا الساعة السابعة والنصف مساء", (24+19)*time.Hour + 30*time.Minute},
+		{"الاجتماع ٥ مارس ٢٠١٧ الساعة ١٠:٠٠", 17, "٥ مارس ٢٠١٧ الساعة ١٠:٠٠", time.Date(2017, 3, 5, 10, 0, 0, 0, time.UTC).Sub(null)},
+		{"موعدنا ۵ مارس", 13, "۵ مارس", time.Date(2016, 3, 5, 0, 0, 0, 0, time.UTC).Sub(null)},
+	}
+
+	ApplyFixtures(t, "ar.All...", w, fixt)
+}
@@ -0,0 +1,51 @@
+package ar
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"اليوم" -> today
+	"غداً", "بكرة" -> tomorrow
+	"بعد غد" -> the day after tomorrow
+	"أمس", "البارحة" -> yesterday
+	"أول أمس" -> the day before yesterday
+*/
+
+var CASUAL_DATES = map[string]int{
+	"اليوم":    0,
+	"غدا":      1,
+	"بكرة":     1,
+	"بكرا":     1,
+	"بعد غد":   2,
+	"بعد الغد": 2,
+	"بعد بكرة": 2,
+	"أمس":      -1,
+	"الأمس":    -1,
+	"البارحة":  -1,
+	"امبارح":   -1,
+	"أول أمس":  -2,
+	"قبل أمس":  -2,
+}
+
+func CasualDate(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?:\\P{L}|^)" +
+			"(" + words(keys(CASUAL_DATES)...) + ")" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			n, ok := lookup(CASUAL_DATES, m.Captures[0])
+			if !ok {
+				return false, nil
+			}
+
+			c.Duration = time.Duration(n) * 24 * time.Hour
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
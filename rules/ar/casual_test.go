@@ -0,0 +1,67 @@
+package ar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ar"
+)
+
+func TestCasualDate(t *testing.T) {
+	fixt := []Fixture{
+		{"اليوم", 0, "اليوم", 0},
+		{"غدا", 0, "غدا", 24 * time.Hour},
+		{"غداً", 0, "غداً", 24 * time.Hour},
+		{"بكرة", 0, "بكرة", 24 * time.Hour},
+		{"بعد غد", 0, "بعد غد", 2 * 24 * time.Hour},
+		{"أمس", 0, "أمس", -(24 * time.Hour)},
+		{"امس", 0, "امس", -(24 * time.Hour)},
+		{"البارحة", 0, "البارحة", -(24 * time.Hour)},
+		{"أول أمس", 0, "أول أمس", -(2 * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(ar.CasualDate(rules.Skip))
+
+	ApplyFixtures(t, "ar.CasualDate", w, fixt)
+}
+
+func TestCasualTime(t *testing.T) {
+	fixt := []Fixture{
+		{"صباحاً", 0, "صباحاً", 8 * time.Hour},
+		{"في الصباح", 0, "في الصباح", 8 * time.Hour},
+		{"ظهرا", 0, "ظهرا", 12 * time.Hour},
+		{"بعد الظهر", 0, "بعد الظهر", 15 * time.Hour},
+		{"مساءً", 0, "مساءً", 18 * time.Hour},
+		{"الليلة", 0, "الليلة", 18 * time.Hour},
+		{"ليلاً", 0, "ليلاً", 23 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(ar.CasualTime(rules.Skip))
+
+	ApplyFixtures(t, "ar.CasualTime", w, fixt)
+}
+
+func TestMidnight(t *testing.T) {
+	fixt := []Fixture{
+		{"منتصف الليل", 0, "منتصف الليل", 24 * time.Hour},
+		{"عند منتصف الليل", 0, "عند منتصف الليل", 24 * time.Hour},
+		{"في منتصف الليل", 0, "في منتصف الليل", 24 * time.Hour},
+		{"منتصف النهار", 0, "منتصف النهار", 12 * time.Hour},
+		{"الظهيرة", 0, "الظهيرة", 12 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(ar.Midnight(rules.Override))
+
+	ApplyFixtures(t, "ar.Midnight", w, fixt)
+
+	fixtnil := []Fixture{
+		{"بعد الظهر", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "ar.Midnight nil", w, fixtnil)
+}
@@ -0,0 +1,41 @@
+package ar
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+)
+
+/*
+	"صباحاً", "في الصباح" -> 8:00
+	"ظهراً" -> 12:00
+	"بعد الظهر", "عصراً" -> 15:00
+	"مساءً", "الليلة" -> 18:00
+	"ليلاً" -> 23:00
+*/
+
+func CasualTime(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?:\\P{L}|^)" +
+			"(" + dayParts() + "|(" + words("الليلة", "هذا المساء") + "))" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			p, ok := dayPart(m.Captures[0])
+			if m.Captures[1] != "" {
+				p, ok = common.Evening, true
+			}
+			if !ok {
+				return false, nil
+			}
+
+			c.Hour = pointer.ToInt(p.Hour(o))
+			c.Minute = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
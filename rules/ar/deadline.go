@@ -0,0 +1,29 @@
+package ar
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/pkg/errors"
+)
+
+/*
+	"بعد ٣ أيام", "بعد ثلاثة أيام"
+	"خلال ساعة", "في غضون ساعتين"
+	"بعد ساعة ونصف"
+	"بعد نصف ساعة"
+*/
+
+func Deadline(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?:\\P{L}|^)" +
+			"((?:" + words("بعد", "خلال", "في خلال", "في غضون") + ")\\s+" + relative() + ")" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			ok, err := applyRelative(m.Captures[1:], 1, c, ref)
+			return ok, errors.Wrap(err, "deadline rule")
+		},
+		Strategy: s,
+	}
+}
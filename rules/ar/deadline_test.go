@@ -0,0 +1,40 @@
+package ar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ar"
+)
+
+func TestDeadline(t *testing.T) {
+	fixt := []Fixture{
+		{"بعد ٣ أيام", 0, "بعد ٣ أيام", 3 * 24 * time.Hour},
+		{"بعد ثلاثة أيام", 0, "بعد ثلاثة أيام", 3 * 24 * time.Hour},
+		{"بعد ساعة", 0, "بعد ساعة", time.Hour},
+		{"خلال ساعتين", 0, "خلال ساعتين", 2 * time.Hour},
+		{"في غضون يومين", 0, "في غضون يومين", 2 * 24 * time.Hour},
+		{"بعد ساعة ونصف", 0, "بعد ساعة ونصف", time.Hour + 30*time.Minute},
+		{"بعد نصف ساعة", 0, "بعد نصف ساعة", 30 * time.Minute},
+		{"بعد ١٥ دقيقة", 0, "بعد ١٥ دقيقة", 15 * time.Minute},
+		{"بعد أسبوع", 0, "بعد أسبوع", 7 * 24 * time.Hour},
+		{"بعد شهرين", 0, "بعد شهرين", (31 + 29) * 24 * time.Hour},
+		{"بعد سنة", 0, "بعد سنة", 366 * 24 * time.Hour},
+		{"ذكرني بعد ٥ دقائق", 11, "بعد ٥ دقائق", 5 * time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Normalize(rules.Digits)
+	w.Add(ar.Deadline(rules.Override))
+
+	ApplyFixtures(t, "ar.Deadline", w, fixt)
+
+	fixtnil := []Fixture{
+		{"بعد الظهر", 0, "", 0},
+		{"بعد الأسبوع", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "ar.Deadline nil", w, fixtnil)
+}
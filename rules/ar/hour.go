@@ -0,0 +1,38 @@
+package ar
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"الساعة ٩", "الساعة التاسعة" -> 9:00
+	"٥ مساءً", "الساعة الخامسة مساءً" -> 17:00
+*/
+
+func Hour(s rules.Strategy) rules.Rule {
+	number := "[0-9]{1,2}|" + words(keys(HOUR_ORDINALS)...)
+
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?:[^\\p{L}\\p{N}]|^)" +
+			"(?:(" + words("الساعة") + ")\\s+(" + number + ")(?:\\s+(" + dayParts() + "))?" +
+			"|([0-9]{1,2})\\s+(" + dayParts() + "))" +
+			"(?:[^\\p{L}\\p{N}]|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			h, ok := hour(m.Captures[1]+m.Captures[3], m.Captures[2]+m.Captures[4], o)
+			if !ok {
+				return false, nil
+			}
+
+			c.Hour = &h
+			c.Minute = pointer.ToInt(0)
+			c.Second = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
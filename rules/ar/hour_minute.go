@@ -0,0 +1,44 @@
+package ar
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+	"github.com/pkg/errors"
+)
+
+/*
+	"١٧:٣٠", "الساعة 17:30" -> 17:30
+	"5:30 مساءً" -> 17:30
+*/
+
+func HourMinute(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?:[^\\p{L}\\p{N}]|^)" +
+			"(?:(" + words("الساعة") + ")\\s+)?" +
+			"([01]?[0-9]|2[0-3]):([0-5][0-9])" +
+			"(?:\\s+(" + dayParts() + "))?" +
+			"(?:[^\\p{L}\\p{N}]|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			h, ok := hour(m.Captures[1], m.Captures[3], o)
+			if !ok {
+				return false, nil
+			}
+
+			minutes, err := strconv.Atoi(m.Captures[2])
+			if err != nil {
+				return false, errors.Wrap(err, "hour minute rule")
+			}
+
+			c.Hour = &h
+			c.Minute = &minutes
+			c.Second = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
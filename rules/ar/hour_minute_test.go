@@ -0,0 +1,32 @@
+package ar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ar"
+)
+
+func TestHourMinute(t *testing.T) {
+	fixt := []Fixture{
+		{"١٧:٣٠", 0, "١٧:٣٠", 17*time.Hour + 30*time.Minute},
+		{"الساعة 17:30", 0, "الساعة 17:30", 17*time.Hour + 30*time.Minute},
+		{"۵:۳۰ مساء", 0, "۵:۳۰ مساء", 17*time.Hour + 30*time.Minute},
+		{"٨:١٥ صباحاً", 0, "٨:١٥ صباحاً", 8*time.Hour + 15*time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Normalize(rules.Digits)
+	w.Add(ar.HourMinute(rules.Override))
+
+	ApplyFixtures(t, "ar.HourMinute", w, fixt)
+
+	fixtnil := []Fixture{
+		{"٢٥:٣٠", 0, "", 0},
+		{"١٧:٦٠", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "ar.HourMinute nil", w, fixtnil)
+}
@@ -0,0 +1,88 @@
+package ar
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"الساعة الخامسة والنصف" -> 5:30
+	"الخامسة والربع" -> 5:15
+	"الخامسة إلا ربعاً" -> 4:45
+	"الساعة ٥ و١٠ دقائق" -> 5:10
+	"الثامنة إلا ثلثاً مساءً" -> 19:40
+*/
+
+// MINUTE_WORDS holds the minutes said after an hour, like "النصف" in
+// "الخامسة والنصف"
+var MINUTE_WORDS = map[string]int{
+	"خمس":   5,
+	"خمسة":  5,
+	"عشر":   10,
+	"عشرة":  10,
+	"ربع":   15,
+	"الربع": 15,
+	"ربعا":  15,
+	"ثلث":   20,
+	"الثلث": 20,
+	"ثلثا":  20,
+	"نصف":   30,
+	"النصف": 30,
+}
+
+func HourRelative(s rules.Strategy) rules.Rule {
+	number := "[0-9]{1,2}|" + words(keys(HOUR_ORDINALS)...)
+	minutes := "[0-9]{1,2}|" + words(keys(MINUTE_WORDS)...)
+
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?:[^\\p{L}\\p{N}]|^)" +
+			"(?:(" + words("الساعة") + ")\\s+)?" +
+			"(" + number + ")\\s+" +
+			"(?:" + words("و") + "\\s*(" + minutes + ")|(" + words("إلا") + ")\\s*(" + minutes + "))" +
+			"(?:\\s+(" + words("دقيقة", "دقائق") + "))?" +
+			"(?:\\s+(" + dayParts() + "))?" +
+			"(?:[^\\p{L}\\p{N}]|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			word := m.Captures[2] + m.Captures[4]
+			n, ok := lookup(MINUTE_WORDS, word)
+			if !ok {
+				var err error
+				if n, err = strconv.Atoi(word); err != nil {
+					return false, nil
+				}
+			}
+			if n <= 0 || n >= 60 {
+				return false, nil
+			}
+
+			h, ok := lookup(HOUR_ORDINALS, m.Captures[1])
+			if !ok {
+				h, _ = strconv.Atoi(m.Captures[1])
+			}
+			if h > 12 {
+				return false, nil
+			}
+			if m.Captures[3] != "" {
+				n = 60 - n
+				h--
+				if h == 0 {
+					h = 12
+				}
+			}
+			if part, ok := dayPart(m.Captures[6]); ok {
+				h = part.Clock(h, o)
+			}
+
+			c.Hour = &h
+			c.Minute = &n
+			c.Second = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
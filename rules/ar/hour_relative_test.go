@@ -0,0 +1,35 @@
+package ar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ar"
+)
+
+func TestHourRelative(t *testing.T) {
+	fixt := []Fixture{
+		{"الساعة الخامسة والنصف", 0, "الساعة الخامسة والنصف", 5*time.Hour + 30*time.Minute},
+		{"الخامسة والربع", 0, "الخامسة والربع", 5*time.Hour + 15*time.Minute},
+		{"الخامسة إلا ربعاً", 0, "الخامسة إلا ربعاً", 4*time.Hour + 45*time.Minute},
+		{"الواحدة إلا ربع", 0, "الواحدة إلا ربع", 12*time.Hour + 45*time.Minute},
+		{"الساعة ٥ و١٠ دقائق", 0, "الساعة ٥ و١٠ دقائق", 5*time.Hour + 10*time.Minute},
+		{"الثامنة إلا ثلثاً مساءً", 0, "الثامنة إلا ثلثاً مساءً", 19*time.Hour + 40*time.Minute},
+		{"السابعة والنصف مساء", 0, "السابعة والنصف مساء", 19*time.Hour + 30*time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Normalize(rules.Digits)
+	w.Add(ar.HourRelative(rules.Override))
+
+	ApplyFixtures(t, "ar.HourRelative", w, fixt)
+
+	fixtnil := []Fixture{
+		{"الساعة ١٧ والنصف", 0, "", 0},
+		{"الخامسة و٦٠ دقيقة", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "ar.HourRelative nil", w, fixtnil)
+}
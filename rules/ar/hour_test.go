@@ -0,0 +1,40 @@
+package ar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ar"
+)
+
+func TestHour(t *testing.T) {
+	fixt := []Fixture{
+		{"الساعة ٩", 0, "الساعة ٩", 9 * time.Hour},
+		{"الساعة 9", 0, "الساعة 9", 9 * time.Hour},
+		{"الساعة التاسعة", 0, "الساعة التاسعة", 9 * time.Hour},
+		{"الساعه التاسعه", 0, "الساعه التاسعه", 9 * time.Hour},
+		{"٥ مساءً", 0, "٥ مساءً", 17 * time.Hour},
+		{"الساعة الخامسة مساءً", 0, "الساعة الخامسة مساءً", 17 * time.Hour},
+		{"الساعة ١٢ ظهراً", 0, "الساعة ١٢ ظهراً", 12 * time.Hour},
+		{"الساعة ١ ظهرا", 0, "الساعة ١ ظهرا", 13 * time.Hour},
+		{"١٢ ليلاً", 0, "١٢ ليلاً", 24 * time.Hour},
+		{"الساعة الحادية عشرة ليلا", 0, "الساعة الحادية عشرة ليلا", 23 * time.Hour},
+		{"الساعة ٧ صباحاً", 0, "الساعة ٧ صباحاً", 7 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Normalize(rules.Digits)
+	w.Add(ar.Hour(rules.Override))
+
+	ApplyFixtures(t, "ar.Hour", w, fixt)
+
+	fixtnil := []Fixture{
+		{"٥ ساعات", 0, "", 0},
+		{"الساعة ٢٥", 0, "", 0},
+		{"١٥ مساءً", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "ar.Hour nil", w, fixtnil)
+}
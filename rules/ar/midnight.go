@@ -0,0 +1,40 @@
+package ar
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"منتصف الليل", "عند منتصف الليل" -> the end of today
+	"منتصف النهار", "الظهيرة" -> 12:00
+
+	A midnight belongs to the end of its day unless Options.Midnight is set
+	to rules.MidnightStartOfDay.
+*/
+
+func Midnight(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?:\\P{L}|^)" +
+			"(?:(" + words("عند", "في") + ")\\s+)?" +
+			"(?:(" + words("منتصف الليل") + ")|(" + words("منتصف النهار", "الظهيرة", "وقت الظهر", "عند الظهر") + "))" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			if m.Captures[1] != "" {
+				c.Hour = pointer.ToInt(o.MidnightHour())
+			} else if o.Noon != 0 {
+				c.Hour = &o.Noon
+			} else {
+				c.Hour = pointer.ToInt(12)
+			}
+			c.Minute = pointer.ToInt(0)
+			c.Second = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
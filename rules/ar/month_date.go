@@ -0,0 +1,56 @@
+package ar
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/pkg/errors"
+)
+
+/*
+	"٥ مارس", "5 آذار" -> March 5
+	"٥ مارس ٢٠١٧", "5 من مارس 2017م" -> March 5, 2017
+	"١ كانون الثاني" -> January 1
+*/
+
+func MonthDate(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?:[^\\p{L}\\p{N}]|^)" +
+			"([0-9]{1,2})\\s+(?:" + words("من") + "\\s+)?" +
+			"(" + words(keys(MONTH_OFFSET)...) + ")" +
+			"(?:\\s+([0-9]{4})(?:\\s*(" + words("م") + "))?)?" +
+			"(?:[^\\p{L}\\p{N}]|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			month, ok := lookup(MONTH_OFFSET, m.Captures[1])
+			if !ok {
+				return false, nil
+			}
+
+			day, err := strconv.Atoi(m.Captures[0])
+			if err != nil {
+				return false, errors.Wrap(err, "month date rule")
+			}
+			if day < 1 || day > 31 {
+				return false, nil
+			}
+
+			if m.Captures[2] != "" {
+				year, err := strconv.Atoi(m.Captures[2])
+				if err != nil {
+					return false, errors.Wrap(err, "month date rule")
+				}
+				c.Year = &year
+				common.ForgetYear(c, year)
+			}
+
+			c.Day = &day
+			c.Month = &month
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
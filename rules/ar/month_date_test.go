@@ -0,0 +1,35 @@
+package ar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ar"
+)
+
+func TestMonthDate(t *testing.T) {
+	fixt := []Fixture{
+		{"٥ مارس", 0, "٥ مارس", time.Date(2016, 3, 5, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"5 آذار", 0, "5 آذار", time.Date(2016, 3, 5, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"٥ مارس ٢٠١٧", 0, "٥ مارس ٢٠١٧", time.Date(2017, 3, 5, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"5 من مارس 2017م", 0, "5 من مارس 2017م", time.Date(2017, 3, 5, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"١ كانون الثاني", 0, "١ كانون الثاني", time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"٢٥ ديسمبر", 0, "٢٥ ديسمبر", time.Date(2016, 12, 25, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"١٥ إبريل", 0, "١٥ إبريل", time.Date(2016, 4, 15, 0, 0, 0, 0, time.UTC).Sub(null)},
+	}
+
+	w := when.New(nil)
+	w.Normalize(rules.Digits)
+	w.Add(ar.MonthDate(rules.Override))
+
+	ApplyFixtures(t, "ar.MonthDate", w, fixt)
+
+	fixtnil := []Fixture{
+		{"٣٢ مارس", 0, "", 0},
+		{"٥ مارسيل", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "ar.MonthDate nil", w, fixtnil)
+}
@@ -0,0 +1,28 @@
+package ar
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/pkg/errors"
+)
+
+/*
+	"منذ ٥ دقائق", "قبل خمس دقائق"
+	"منذ يومين"
+	"قبل أسبوع"
+*/
+
+func PastTime(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?:\\P{L}|^)" +
+			"((?:" + words("منذ", "قبل") + ")\\s+" + relative() + ")" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			ok, err := applyRelative(m.Captures[1:], -1, c, ref)
+			return ok, errors.Wrap(err, "past time rule")
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,33 @@
+package ar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ar"
+)
+
+func TestPastTime(t *testing.T) {
+	fixt := []Fixture{
+		{"منذ ٥ دقائق", 0, "منذ ٥ دقائق", -(5 * time.Minute)},
+		{"قبل خمس دقائق", 0, "قبل خمس دقائق", -(5 * time.Minute)},
+		{"منذ يومين", 0, "منذ يومين", -(2 * 24 * time.Hour)},
+		{"قبل أسبوع", 0, "قبل أسبوع", -(7 * 24 * time.Hour)},
+		{"منذ ساعة", 0, "منذ ساعة", -(time.Hour)},
+		{"قبل ٣ أشهر", 0, "قبل ٣ أشهر", -((31 + 30 + 31) * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Normalize(rules.Digits)
+	w.Add(ar.PastTime(rules.Override))
+
+	ApplyFixtures(t, "ar.PastTime", w, fixt)
+
+	fixtnil := []Fixture{
+		{"قبل الظهر", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "ar.PastTime nil", w, fixtnil)
+}
@@ -0,0 +1,76 @@
+package ar
+
+import (
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+// relative returns the pattern of an amount and its unit capturing both
+// and the half following them, the amount is optional
+func relative() string {
+	amounts := make([]string, 0, len(AMOUNTS))
+	for k := range AMOUNTS {
+		amounts = append(amounts, k)
+	}
+	units := make([]string, 0, len(UNITS)+len(DUALS))
+	for k := range UNITS {
+		units = append(units, k)
+	}
+	for k := range DUALS {
+		units = append(units, k)
+	}
+
+	return "(?:(" + words(amounts...) + "|[0-9]+(?:[.,][0-9]+)?)\\s+)?" +
+		"(" + words(units...) + ")" +
+		"(?:\\s+(" + words("ونصف", "و نصف") + "))?"
+}
+
+// applyRelative sets the context to the amount and unit of the captures
+// of relative, a unit without an amount is one of it and the dual forms
+// are two
+func applyRelative(captures []string, sign float64, c *rules.Context, ref time.Time) (bool, error) {
+	word := normalize(captures[1])
+
+	var unit romance.Unit
+	amount, found := 0.0, false
+	for k, u := range UNITS {
+		if normalize(k) == word {
+			unit, amount, found = u, 1, true
+		}
+	}
+	for k, u := range DUALS {
+		if normalize(k) == word {
+			unit, amount, found = u, 2, true
+		}
+	}
+	if !found {
+		return false, nil
+	}
+
+	if captures[0] != "" {
+		n, err := parseAmount(captures[0])
+		if err != nil {
+			return false, err
+		}
+		amount = n
+	}
+	if captures[2] != "" {
+		amount += 0.5
+	}
+
+	romance.ApplyAmount(c, ref, sign*amount, unit)
+	return true, nil
+}
+
+// parseAmount converts a digit or spelled-out amount to its value
+func parseAmount(s string) (float64, error) {
+	word := normalize(s)
+	for k, n := range AMOUNTS {
+		if normalize(k) == word {
+			return n, nil
+		}
+	}
+	return romance.ParseAmount(s, nil)
+}
@@ -0,0 +1,48 @@
+package ar
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+)
+
+/*
+	"الأسبوع القادم", "في الأسبوع المقبل" -> 9:00 on the next Monday
+	"الشهر الماضي" -> the previous month
+	"هذا العام", "هذه السنة" -> the current year
+*/
+
+func RelativeWeek(s rules.Strategy) rules.Rule {
+	periods := make([]string, 0, len(PERIODS))
+	for k := range PERIODS {
+		periods = append(periods, k)
+	}
+
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?:\\P{L}|^)" +
+			"(?:(" + words("هذا", "هذه") + ")\\s+(" + words(periods...) + ")" +
+			"|(" + words(periods...) + ")\\s+(" + words(keys(SHIFTS)...) + "))" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			word, shift := m.Captures[1], 0
+			if word == "" {
+				word = m.Captures[2]
+				var ok bool
+				if shift, ok = lookup(SHIFTS, m.Captures[3]); !ok {
+					return false, nil
+				}
+			}
+
+			word = normalize(word)
+			for k, p := range PERIODS {
+				if normalize(k) == word {
+					return common.ApplyPeriod(c, ref, p, shift), nil
+				}
+			}
+			return false, nil
+		},
+		Strategy: s,
+	}
+}
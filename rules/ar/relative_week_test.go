@@ -0,0 +1,35 @@
+package ar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ar"
+)
+
+func TestRelativeWeek(t *testing.T) {
+	fixt := []Fixture{
+		{"الأسبوع القادم", 0, "الأسبوع القادم", (5*24 + 9) * time.Hour},
+		{"في الأسبوع المقبل", 5, "الأسبوع المقبل", (5*24 + 9) * time.Hour},
+		{"الأسبوع الماضي", 0, "الأسبوع الماضي", -(7 * 24 * time.Hour)},
+		{"الشهر القادم", 0, "الشهر القادم", (26*24 + 9) * time.Hour},
+		{"الشهر الماضي", 0, "الشهر الماضي", -(31 * 24 * time.Hour)},
+		{"هذا الشهر", 0, "هذا الشهر", 0},
+		{"السنة القادمة", 0, "السنة القادمة", 366 * 24 * time.Hour},
+		{"العام الماضي", 0, "العام الماضي", -(365 * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Normalize(rules.Digits)
+	w.Add(ar.RelativeWeek(rules.Override))
+
+	ApplyFixtures(t, "ar.RelativeWeek", w, fixt)
+
+	fixtnil := []Fixture{
+		{"الأسبوع الأول", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "ar.RelativeWeek nil", w, fixtnil)
+}
@@ -0,0 +1,55 @@
+package ar
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"الجمعة", "يوم الجمعة" -> the upcoming Friday
+	"الجمعة القادمة", "الجمعة المقبلة" -> the upcoming Friday
+	"الجمعة الماضية" -> the past Friday
+	"هذا الأحد" -> the upcoming Sunday or today
+*/
+
+func Weekday(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?:\\P{L}|^)" +
+			"(?:(" + words("هذا", "هذه") + ")\\s+)?" +
+			"(?:(" + words("يوم") + ")\\s+)?" +
+			"(" + words(keys(WEEKDAY_OFFSET)...) + ")" +
+			"(?:\\s+(" + words(keys(SHIFTS)...) + "))?" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			day, ok := lookup(WEEKDAY_OFFSET, m.Captures[2])
+			if !ok {
+				return false, nil
+			}
+			c.Weekday = pointer.ToInt(day)
+
+			shift, _ := lookup(SHIFTS, m.Captures[3])
+			diff := day - int(ref.Weekday())
+			switch {
+			case shift < 0:
+				if diff >= 0 {
+					diff -= 7
+				}
+			case m.Captures[0] != "":
+				if diff < 0 {
+					diff += 7
+				}
+			default:
+				if diff <= 0 {
+					diff += 7
+				}
+			}
+
+			c.Duration = time.Duration(diff) * 24 * time.Hour
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
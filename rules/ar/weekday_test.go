@@ -0,0 +1,41 @@
+package ar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ar"
+)
+
+func TestWeekday(t *testing.T) {
+	// current is Wednesday
+	fixt := []Fixture{
+		{"الجمعة", 0, "الجمعة", 2 * 24 * time.Hour},
+		{"يوم الجمعة", 0, "يوم الجمعة", 2 * 24 * time.Hour},
+		{"يوم الأحد", 0, "يوم الأحد", 4 * 24 * time.Hour},
+		{"يوم الاحد", 0, "يوم الاحد", 4 * 24 * time.Hour},
+		{"الأربعاء", 0, "الأربعاء", 7 * 24 * time.Hour},
+		{"الاثنين القادم", 0, "الاثنين القادم", 5 * 24 * time.Hour},
+		{"السبت المقبل", 0, "السبت المقبل", 3 * 24 * time.Hour},
+		{"الأربعاء الماضي", 0, "الأربعاء الماضي", -(7 * 24 * time.Hour)},
+		{"يوم الاثنين الماضي", 0, "يوم الاثنين الماضي", -(2 * 24 * time.Hour)},
+		{"هذا الخميس", 0, "هذا الخميس", 24 * time.Hour},
+		{"هذا الأربعاء", 0, "هذا الأربعاء", 0},
+		{"نلتقي يوم الجمعة القادمة", 11, "يوم الجمعة القادمة", 2 * 24 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Normalize(rules.Digits)
+	w.Add(ar.Weekday(rules.Override))
+
+	ApplyFixtures(t, "ar.Weekday", w, fixt)
+
+	fixtnil := []Fixture{
+		{"الجمعات", 0, "", 0},
+		{"جمعة مباركة", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "ar.Weekday nil", w, fixtnil)
+}
@@ -55,6 +55,8 @@ func TestAll(t *testing.T) {
 		{"na tarde de sexta", 3, "tarde de sexta", ((2 * 24) + 15) * time.Hour},
 		{"na próxima terça às 14:00", 3, "próxima terça às 14:00", ((6 * 24) + 14) * time.Hour},
 		{"na próxima terça às 2p", 3, "próxima terça às 2p", ((6 * 24) + 14) * time.Hour},
+		{"5 de março de 2017", 0, "5 de março de 2017", time.Date(2017, 3, 5, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"sexta da semana que vem às 10:00", 0, "sexta da semana que vem às 10:00", ((9 * 24) + 10) * time.Hour},
 		{"na próxima quarta-feira às 2:25 p.m.", 3, "próxima quarta-feira às 2:25 p.m.", (((7 * 24) + 14) * time.Hour) + (25 * time.Minute)},
 		{"11 am última terça", 0, "11 am última terça", -13 * time.Hour},
 	}
@@ -10,17 +10,11 @@ import (
 )
 
 func CasualTime(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
-		RegExp: regexp.MustCompile(`(?i)(?:\W|^)(((?:nesta|esta|ao|à))?\s*(manhã|tarde|noite|meio[- ]dia))`),
+		RegExp: regexp.MustCompile(`(?i)(?:\W|^)(((?:nesta|esta|ao|à|de))?\s*(manhã|tarde|noite|meio[- ]dia))`),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
 			lower := strings.ToLower(strings.TrimSpace(m.String()))
 
-			if (c.Hour != nil || c.Minute != nil) && !overwrite {
-				return false, nil
-			}
-
 			switch {
 			case strings.Contains(lower, "tarde"):
 				if o.Afternoon != 0 {
@@ -54,5 +48,6 @@ func CasualTime(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
@@ -0,0 +1,31 @@
+package br_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/br"
+)
+
+func TestDateWithYear(t *testing.T) {
+	fixt := []Fixture{
+		{"5 de março de 2017", 0, "5 de março de 2017", time.Date(2017, 3, 5, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"em 1º de janeiro de 2020", 3, "1º de janeiro de 2020", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"primeiro de maio 2018", 0, "primeiro de maio 2018", time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"24 dez 2015", 0, "24 dez 2015", -(13 * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(br.DateWithYear(rules.Override))
+
+	ApplyFixtures(t, "br.DateWithYear", w, fixt)
+
+	fixtnil := []Fixture{
+		{"5 de março", 0, "", 0},
+		{"45 de março de 2017", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "br.DateWithYear nil", w, fixtnil)
+}
@@ -10,8 +10,6 @@ import (
 )
 
 func ExactMonthDate(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)" +
 			"(?:\\W|^)" +
@@ -22,8 +20,6 @@ func ExactMonthDate(s rules.Strategy) rules.Rule {
 		),
 
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			_ = overwrite
-
 			num := strings.ToLower(strings.TrimSpace(m.Captures[0]))
 			ord := strings.ToLower(strings.TrimSpace(m.Captures[1]))
 			mon := strings.ToLower(strings.TrimSpace(m.Captures[2]))
@@ -57,5 +53,6 @@ func ExactMonthDate(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
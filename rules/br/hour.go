@@ -3,6 +3,7 @@ package br
 import (
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -18,6 +19,8 @@ import (
 	"5A."
 	"5P."
 	"11 P.M."
+	"às 17h", "às 17 horas", "17h" -> 17:00
+	"às 5 da tarde" -> 17:00
 	https://play.golang.org/p/2Gh35Sl3KP
 */
 
@@ -25,12 +28,33 @@ func Hour(s rules.Strategy) rules.Rule {
 
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
-			"(\\d{1,2})" +
+			"(?:(\\d{1,2})" +
 			"(?:\\s*(A\\.|P\\.|A\\.M\\.|P\\.M\\.|AM?|PM?))" +
+			"|(?:(às?|as)\\s+(\\d{1,2})(\\s*h|\\s+horas?)?|(\\d{1,2})(h))" +
+			"(?:\\s+(da\\s+manhã|da\\s+tarde|da\\s+noite))?)" +
 			"(?:\\W|$)"),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if c.Hour != nil && s != rules.Override {
-				return false, nil
+			zero := 0
+
+			// "às 17h" is of a 24-hour clock, "às 5 da tarde" of a 12-hour
+			// one with the part of the day
+			if m.Captures[0] == "" {
+				hour, err := strconv.Atoi(m.Captures[3] + m.Captures[5])
+				if err != nil {
+					return false, errors.Wrap(err, "hour rule")
+				}
+				if hour > 23 {
+					return false, nil
+				}
+				if part, ok := DAY_PARTS[strings.Join(strings.Fields(strings.ToLower(m.Captures[7])), " ")]; ok {
+					if hour > 12 {
+						return false, nil
+					}
+					hour = part.Clock(hour, o)
+				}
+				c.Hour = &hour
+				c.Minute = &zero
+				return true, nil
 			}
 
 			hour, err := strconv.Atoi(m.Captures[0])
@@ -42,9 +66,11 @@ func Hour(s rules.Strategy) rules.Rule {
 				return false, nil
 			}
 
-			zero := 0
 			switch m.Captures[1][0] {
 			case 65, 97: // am
+				if hour == 12 {
+					hour = 0
+				}
 				c.Hour = &hour
 			case 80, 112: // pm
 				if hour < 12 {
@@ -56,5 +82,6 @@ func Hour(s rules.Strategy) rules.Rule {
 			c.Minute = &zero
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
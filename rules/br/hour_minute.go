@@ -37,10 +37,6 @@ func HourMinute(s rules.Strategy) rules.Rule {
 			"(?:\\s*(A\\.|P\\.|A\\.M\\.|P\\.M\\.|AM?|PM?))?" +
 			"(?:\\W|$)"),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if (c.Hour != nil || c.Minute != nil) && s != rules.Override {
-				return false, nil
-			}
-
 			hour, err := strconv.Atoi(m.Captures[0])
 			if err != nil {
 				return false, errors.Wrap(err, "hour minute rule")
@@ -62,6 +58,9 @@ func HourMinute(s rules.Strategy) rules.Rule {
 				}
 				switch m.Captures[2][0] {
 				case 65, 97: // am
+					if hour == 12 {
+						hour = 0
+					}
 					c.Hour = &hour
 				case 80, 112: // pm
 					if hour < 12 {
@@ -78,5 +77,6 @@ func HourMinute(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
@@ -0,0 +1,97 @@
+package br
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"quinze para as oito" -> 7:45
+	"dez para o meio-dia" -> 11:50
+	"oito e meia" -> 8:30
+	"as três e quinze da tarde" -> 15:15
+*/
+
+func HourRelative(s rules.Strategy) rules.Rule {
+	hour := "(" + INTEGER_WORDS_PATTERN + "|meio-dia|[0-9]{1,2})"
+
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:[^\\p{L}\\p{N}]|^)" +
+			"(?:" +
+			"(" + MINUTE_WORDS_PATTERN + "|[0-9]{1,2})(?:\\s+minutos?)?\\s+para\\s+(?:as?|o)\\s+" + hour +
+			"|" + hour + "(?:\\s+horas?)?\\s+e\\s+(meia|" + MINUTE_WORDS_PATTERN + ")" +
+			")" +
+			"(?:\\s+(?:(da\\s+manhã)|(da\\s+tarde)|(da\\s+noite)))?" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			h, ok := parseHour(m.Captures[1] + m.Captures[2])
+			if !ok {
+				return false, nil
+			}
+
+			var minutes int
+			if m.Captures[0] != "" {
+				before, ok := parseMinutes(m.Captures[0])
+				if !ok || before >= 60 {
+					return false, nil
+				}
+				h--
+				minutes = 60 - before
+			} else {
+				minutes, _ = parseMinutes(m.Captures[3])
+			}
+
+			switch {
+			case m.Captures[4] != "":
+				if h == 12 {
+					h = 0
+				}
+			case m.Captures[5] != "":
+				if h < 12 {
+					h += 12
+				}
+			case m.Captures[6] != "":
+				if h == 12 {
+					h = 0
+				} else if h >= 6 && h < 12 {
+					h += 12
+				}
+			}
+
+			zero := 0
+			c.Hour = &h
+			c.Minute = &minutes
+			c.Second = &zero
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
+
+// parseHour converts the hour of the relative times to its value
+func parseHour(s string) (int, bool) {
+	s = strings.ToLower(s)
+	if s == "meio-dia" {
+		return 12, true
+	}
+	if n, ok := INTEGER_WORDS[s]; ok {
+		return n, true
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil && n <= 23
+}
+
+// parseMinutes converts the minutes said around an hour to their value
+func parseMinutes(s string) (int, bool) {
+	s = strings.Join(strings.Fields(strings.ToLower(s)), " ")
+	if n, ok := MINUTE_WORDS[s]; ok {
+		return n, true
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil
+}
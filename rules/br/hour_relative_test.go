@@ -0,0 +1,33 @@
+package br_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/br"
+)
+
+func TestHourRelative(t *testing.T) {
+	fixt := []Fixture{
+		{"quinze para as oito", 0, "quinze para as oito", 7*time.Hour + 45*time.Minute},
+		{"dez para o meio-dia", 0, "dez para o meio-dia", 11*time.Hour + 50*time.Minute},
+		{"chego às oito e meia", 10, "oito e meia", 8*time.Hour + 30*time.Minute},
+		{"três e quinze da tarde", 0, "três e quinze da tarde", 15*time.Hour + 15*time.Minute},
+		{"5 minutos para as 10 da noite", 0, "5 minutos para as 10 da noite", 21*time.Hour + 55*time.Minute},
+		{"nove e vinte e cinco", 0, "nove e vinte e cinco", 9*time.Hour + 25*time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Add(br.HourRelative(rules.Override))
+
+	ApplyFixtures(t, "br.HourRelative", w, fixt)
+
+	fixtnil := []Fixture{
+		{"pão e queijo", 0, "", 0},
+		{"setenta para as oito", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "br.HourRelative nil", w, fixtnil)
+}
@@ -21,6 +21,13 @@ func TestHour(t *testing.T) {
 		{"at 5A.", 3, "5A.", 5 * time.Hour},
 		{"5A.", 0, "5A.", 5 * time.Hour},
 		{"11 P.M.", 0, "11 P.M.", 23 * time.Hour},
+		{"às 17h", 0, "às 17h", 17 * time.Hour},
+		{"chego às 17 horas", 6, "às 17 horas", 17 * time.Hour},
+		{"as 9", 0, "as 9", 9 * time.Hour},
+		{"17h", 0, "17h", 17 * time.Hour},
+		{"às 5 da tarde", 0, "às 5 da tarde", 17 * time.Hour},
+		{"às 6 da manhã", 0, "às 6 da manhã", 6 * time.Hour},
+		{"às 11 da noite", 0, "às 11 da noite", 23 * time.Hour},
 	}
 
 	w := when.New(nil)
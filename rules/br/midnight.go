@@ -0,0 +1,40 @@
+package br
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"à meia-noite" -> the end of today
+	"sexta à meia-noite" -> the end of friday, 00:00 on saturday
+	"amanhã ao meio-dia" -> 12:00 tomorrow
+
+	A midnight belongs to the end of its day unless Options.Midnight is set
+	to rules.MidnightStartOfDay.
+*/
+
+// Midnight handles midnight and noon
+func Midnight(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile(`(?i)(?:\W|^)((?:à\s+|ao\s+)?(meia[- ]noite|meio[- ]dia))(?:\P{L}|$)`),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			if strings.HasPrefix(strings.ToLower(m.Captures[1]), "meia") {
+				c.Hour = pointer.ToInt(o.MidnightHour())
+			} else if o.Noon != 0 {
+				c.Hour = &o.Noon
+			} else {
+				c.Hour = pointer.ToInt(12)
+			}
+			c.Minute = pointer.ToInt(0)
+			c.Second = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,19 @@
+package br
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+/*
+	"próxima semana", "semana que vem" -> 9:00 on the next Monday
+	"semana passada", "última semana" -> -7 days
+	"sexta da semana que vem" -> Friday of the next week
+	"mês que vem" -> the first day of the next month
+	"mês passado" -> the previous month
+	"este ano", "neste ano" -> the current year
+*/
+
+func RelativeWeek(s rules.Strategy) rules.Rule {
+	return romance.RelativeWeek(s, PERIOD_WORDS)
+}
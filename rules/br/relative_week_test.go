@@ -0,0 +1,28 @@
+package br_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/br"
+)
+
+func TestRelativeWeek(t *testing.T) {
+	fixt := []Fixture{
+		{"próxima semana", 0, "próxima semana", (5*24 + 9) * time.Hour},
+		{"na semana que vem", 3, "semana que vem", (5*24 + 9) * time.Hour},
+		{"fiz isso semana passada", 9, "semana passada", -(7 * 24 * time.Hour)},
+		{"nesta semana", 0, "nesta semana", 0},
+		{"mês que vem", 0, "mês que vem", (26*24 + 9) * time.Hour},
+		{"no mês passado", 3, "mês passado", -(31 * 24 * time.Hour)},
+		{"próximo ano", 0, "próximo ano", 366 * 24 * time.Hour},
+		{"este ano", 0, "este ano", 0},
+	}
+
+	w := when.New(nil)
+	w.Add(br.RelativeWeek(rules.Override))
+
+	ApplyFixtures(t, "br.RelativeWeek", w, fixt)
+}
@@ -0,0 +1,104 @@
+// Package cjk holds the numerals shared by the Chinese and Japanese rules.
+package cjk
+
+import (
+	"regexp"
+	"strings"
+)
+
+// NUMERAL_PATTERN matches an integer written with the CJK numerals, like
+// "十五", "二十四" or "二〇二五"
+var NUMERAL_PATTERN = `[〇零一二两兩三四五六七八九十百千]+`
+
+// INTEGER_PATTERN matches an integer written with the ASCII, full-width or
+// CJK numerals
+var INTEGER_PATTERN = `(?:[0-9０-９]+|` + NUMERAL_PATTERN + `)`
+
+var DIGITS = map[rune]int{
+	'〇': 0,
+	'零': 0,
+	'一': 1,
+	'二': 2,
+	'两': 2,
+	'兩': 2,
+	'三': 3,
+	'四': 4,
+	'五': 5,
+	'六': 6,
+	'七': 7,
+	'八': 8,
+	'九': 9,
+}
+
+var MULTIPLIERS = map[rune]int{
+	'十': 10,
+	'百': 100,
+	'千': 1000,
+}
+
+// ParseInteger converts an integer written with the ASCII, full-width or
+// CJK numerals to its value. The numerals with multipliers like "二十四"
+// and the positional ones like "二〇二五" are both supported.
+func ParseInteger(s string) (int, bool) {
+	s = Compress(s)
+	if s == "" {
+		return 0, false
+	}
+
+	positional := true
+	for _, r := range s {
+		if _, ok := MULTIPLIERS[r]; ok {
+			positional = false
+			break
+		}
+	}
+
+	total, current := 0, 0
+	for _, r := range s {
+		digit, ok := digit(r)
+		if ok {
+			if positional {
+				total = total*10 + digit
+			} else {
+				current = digit
+			}
+			continue
+		}
+
+		multiplier, ok := MULTIPLIERS[r]
+		if !ok {
+			return 0, false
+		}
+		// "十五" is fifteen
+		if current == 0 {
+			current = 1
+		}
+		total += current * multiplier
+		current = 0
+	}
+
+	return total + current, true
+}
+
+// digit returns the value of an ASCII, full-width or CJK digit
+func digit(r rune) (int, bool) {
+	switch {
+	case r >= '0' && r <= '9':
+		return int(r - '0'), true
+	case r >= '０' && r <= '９':
+		return int(r - '０'), true
+	}
+	d, ok := DIGITS[r]
+	return d, ok
+}
+
+var spaces = regexp.MustCompile(`\s+`)
+
+// Compress removes all the whitespace from the string, the CJK text has
+// none between the words
+func Compress(s string) string {
+	if s == "" {
+		return ""
+	}
+	return spaces.ReplaceAllString(strings.TrimSpace(s), "")
+}
@@ -0,0 +1,43 @@
+package cjk_test
+
+import (
+	"testing"
+
+	"github.com/olebedev/when/rules/cjk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInteger(t *testing.T) {
+	fixt := []struct {
+		Text  string
+		Value int
+	}{
+		{"0", 0},
+		{"15", 15},
+		{"１５", 15},
+		{"零", 0},
+		{"三", 3},
+		{"两", 2},
+		{"十", 10},
+		{"十五", 15},
+		{"二十", 20},
+		{"二十四", 24},
+		{"五十九", 59},
+		{"零五", 5},
+		{"一百零五", 105},
+		{"二千二十五", 2025},
+		{"二〇二五", 2025},
+		{"十 五", 15},
+	}
+
+	for i, f := range fixt {
+		v, ok := cjk.ParseInteger(f.Text)
+		require.True(t, ok, "[cjk.ParseInteger] ok #%d %s", i, f.Text)
+		require.Equal(t, f.Value, v, "[cjk.ParseInteger] value #%d %s", i, f.Text)
+	}
+
+	for i, s := range []string{"", "半", "三刻", "a1"} {
+		_, ok := cjk.ParseInteger(s)
+		require.False(t, ok, "[cjk.ParseInteger nil] ok #%d %s", i, s)
+	}
+}
@@ -0,0 +1,17 @@
+package rules
+
+// Clustering tells how the parser puts the matches found in a text together
+// into one time
+type Clustering int
+
+const (
+	// ClusterByDistance puts together the matches no more than
+	// Options.Distance bytes apart
+	ClusterByDistance Clustering = iota
+	// ClusterByTokens puts together the matches no more than Options.Tokens
+	// words apart, not counting the connectors of the language like "at" or
+	// "on", and the ones a connector brings in from further in the same
+	// sentence, like "at 3pm" of "tomorrow, after the meeting, at 3pm". A
+	// break like "." or ";" parts the matches.
+	ClusterByTokens
+)
@@ -2,7 +2,10 @@ package common
 
 import "github.com/olebedev/when/rules"
 
+var describe = rules.Describer("common")
+
+// All holds the rules of no language, they go after the ones of a language
 var All = []rules.Rule{
-	ISODate(rules.Override),
-	SlashDMY(rules.Override),
+	describe("iso_date", rules.Date, 0, ISODate(rules.Override)),
+	describe("slash_dmy", rules.Date, 0, SlashDMY(rules.Override)),
 }
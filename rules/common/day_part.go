@@ -0,0 +1,66 @@
+package common
+
+import "github.com/olebedev/when/rules"
+
+// DayPart is a part of the day, said after an hour it tells the morning
+// hours from the evening ones
+type DayPart int
+
+const (
+	Morning DayPart = iota + 1
+	Afternoon
+	Evening
+	Night
+	Noon
+)
+
+// Hour returns the hour the part of the day refers to on its own, like
+// "вечером", "wieczorem" or "مساءً"
+func (p DayPart) Hour(o *rules.Options) int {
+	switch p {
+	case Morning:
+		if o.Morning != 0 {
+			return o.Morning
+		}
+		return 8
+	case Afternoon:
+		if o.Afternoon != 0 {
+			return o.Afternoon
+		}
+		return 15
+	case Evening:
+		if o.Evening != 0 {
+			return o.Evening
+		}
+		return 18
+	case Noon:
+		if o.Noon != 0 {
+			return o.Noon
+		}
+		return 12
+	}
+	return 23
+}
+
+// Clock turns the hour of a 12-hour clock said with the part of the day
+// to the one of a 24-hour clock, "5 вечера" is 17:00, "6 ночи" is 6:00 and
+// "12 ночи" is the midnight of the options, see Options.MidnightHour
+func (p DayPart) Clock(hour int, o *rules.Options) int {
+	switch p {
+	case Morning:
+		if hour == 12 {
+			hour = 0
+		}
+	case Noon, Afternoon, Evening:
+		if hour < 12 {
+			hour += 12
+		}
+	case Night:
+		if hour == 12 {
+			hour = o.MidnightHour()
+		} else if hour >= 7 && hour < 12 {
+			hour += 12
+		}
+	}
+	return hour
+}
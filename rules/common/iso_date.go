@@ -14,18 +14,15 @@ Examples:
 - 1979-05-27
 - 2023-12-25
 - 2020-01-01
+- 2100-03-01
 */
 
 func ISODate(s rules.Strategy) rules.Rule {
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
-			"((?:19|20)[0-9]{2})-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])" +
+			"([0-9]{4})-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])" +
 			"(?:\\W|$)"),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if (c.Day != nil || c.Month != nil || c.Year != nil) && s != rules.Override {
-				return false, nil
-			}
-
 			year, err := strconv.Atoi(m.Captures[0])
 			if err != nil {
 				return false, nil
@@ -42,8 +39,8 @@ func ISODate(s rules.Strategy) rules.Rule {
 			}
 
 			// Validate day for the given month
-			if getDays(year, month) < day {
-				return false, nil
+			if rules.DaysIn(year, month) < day {
+				return o.Invalid("day %d of %d-%02d", day, year, month)
 			}
 
 			c.Year = &year
@@ -52,5 +49,6 @@ func ISODate(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
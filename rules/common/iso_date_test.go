@@ -7,6 +7,7 @@ import (
 	"github.com/olebedev/when"
 	"github.com/olebedev/when/rules"
 	"github.com/olebedev/when/rules/common"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,6 +27,9 @@ func TestISODate(t *testing.T) {
 		{"2020-01-01", "2020-01-01", 2020, time.January, 1},
 		{"deadline is 2024-06-15", "2024-06-15", 2024, time.June, 15},
 		{"event on 1999-12-31 was fun", "1999-12-31", 1999, time.December, 31},
+		{"2000-02-29", "2000-02-29", 2000, time.February, 29},
+		{"2024-02-29", "2024-02-29", 2024, time.February, 29},
+		{"2100-03-01", "2100-03-01", 2100, time.March, 1},
 	}
 
 	for _, tc := range testCases {
@@ -48,6 +52,9 @@ func TestISODateInvalid(t *testing.T) {
 		"2023-00-01", // Invalid month
 		"2023-02-30", // Invalid day for February
 		"2023-04-31", // Invalid day for April
+		"1900-02-29", // Not a leap year
+		"2023-02-29", // Not a leap year
+		"2100-02-29", // Not a leap year
 	}
 
 	for _, tc := range invalidCases {
@@ -56,3 +63,21 @@ func TestISODateInvalid(t *testing.T) {
 		require.Nil(t, res, "result should be nil for %s", tc)
 	}
 }
+
+func TestISODateStrict(t *testing.T) {
+	w := when.New(&rules.Options{Strict: true})
+	w.Add(common.ISODate(rules.Override))
+
+	res, err := w.Parse("1900-02-29", null)
+	require.Nil(t, res)
+	require.Equal(t, rules.ErrInvalidDate, errors.Cause(err))
+	require.EqualError(t, err, "day 29 of 1900-02: invalid date")
+
+	res, err = w.Parse("2100-02-29", null)
+	require.Nil(t, res)
+	require.Equal(t, rules.ErrInvalidDate, errors.Cause(err))
+
+	res, err = w.Parse("2000-02-29", null)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+}
@@ -0,0 +1,65 @@
+package common
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/pkg/errors"
+)
+
+/*
+	"0800"
+	"1430"
+	"2359"
+	"0000"
+
+	Military time format: 4 digits without separator. It is not a part of
+	All, the locales add it on their own.
+*/
+
+func MilitaryTime(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
+			"((?:[01][0-9]|2[0-3])([0-5][0-9]))" +
+			"(?:\\W|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			timeStr := m.Captures[0]
+			if len(timeStr) != 4 {
+				return false, nil
+			}
+
+			hour, err := strconv.Atoi(timeStr[:2])
+			if err != nil {
+				return false, errors.Wrap(err, "military time rule: hour")
+			}
+
+			minute, err := strconv.Atoi(timeStr[2:])
+			if err != nil {
+				return false, errors.Wrap(err, "military time rule: minute")
+			}
+
+			if hour > 23 || minute > 59 {
+				return false, nil
+			}
+
+			c.Hour = &hour
+			c.Minute = &minute
+			zero := 0
+			c.Second = &zero
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
+
+// ForgetYear unsets the time MilitaryTime took from the year of a date, it
+// reads "5 de março de 2017" as 20:17 otherwise. The rules matching the
+// dates with a year call it after they set the year.
+func ForgetYear(c *rules.Context, year int) {
+	if c.Hour != nil && c.Minute != nil && *c.Hour*100+*c.Minute == year {
+		c.Hour, c.Minute, c.Second = nil, nil, nil
+	}
+}
@@ -0,0 +1,75 @@
+package common
+
+import (
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+// Period is a calendar period the relative expressions like "next week" or
+// "last month" refer to
+type Period int
+
+const (
+	Week Period = iota
+	Month
+	Year
+)
+
+// ApplyPeriod sets the context to the period shift periods away from ref,
+// the current one for zero. All the locales share it, so "next week" means
+// the same in every language:
+//
+//	past weeks -> 7 days back for each of them
+//	next week -> its Monday at 9:00
+//	a day already picked, like in "friday next week" -> that day of the week
+//	past and this month -> the month itself, the day is kept
+//	next month -> its first day at 9:00 unless the time is set
+//	years -> the year itself
+func ApplyPeriod(c *rules.Context, ref time.Time, p Period, shift int) bool {
+	switch p {
+	case Week:
+		// weeks start on Monday
+		from := (int(ref.Weekday()) + 6) % 7
+		if c.Duration != 0 {
+			// move the picked day to the same day of the shifted week
+			day := (int(ref.Add(c.Duration).Weekday()) + 6) % 7
+			c.Duration = time.Duration((shift*7+day-from)*24) * time.Hour
+			return true
+		}
+		switch {
+		case shift < 0:
+			c.Duration = time.Duration(shift*7*24) * time.Hour
+		case shift > 0:
+			days := shift*7 - from
+			c.Duration = time.Duration(days*24) * time.Hour
+			c.Hour = pointer.ToInt(9)
+			c.Minute = pointer.ToInt(0)
+		}
+
+	case Month:
+		month := int(ref.Month()) - 1 + shift
+		year := ref.Year() + month/12
+		if month %= 12; month < 0 {
+			month += 12
+			year--
+		}
+		if year != ref.Year() {
+			c.Year = &year
+		}
+		c.Month = pointer.ToInt(month + 1)
+		if shift > 0 {
+			c.Day = pointer.ToInt(1)
+			if c.Hour == nil && c.Minute == nil {
+				c.Hour = pointer.ToInt(9)
+				c.Minute = pointer.ToInt(0)
+			}
+		}
+
+	case Year:
+		c.Year = pointer.ToInt(ref.Year() + shift)
+	}
+
+	return true
+}
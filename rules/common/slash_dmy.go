@@ -20,16 +20,29 @@ also with "\", gift for windows' users
 https://play.golang.org/p/29LkTfe1Xr
 */
 
+// MONTHS_DAYS is the number of days in the months of a common year, see
+// rules.DaysIn for the leap ones
 var MONTHS_DAYS = []int{
 	0, 31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31,
 }
 
-func getDays(year, month int) int {
-	// naive leap year check
-	if (year-2000)%4 == 0 && month == 2 {
-		return 29
+// setDate sets the date of the context, the year of a date without one is
+// the one it comes next in, false if there is no such date
+func setDate(c *rules.Context, year, month, day int, ref time.Time) bool {
+	if year == -1 {
+		year = ref.Year()
+		if month < int(ref.Month()) || month == int(ref.Month()) && day < ref.Day() {
+			year++
+		}
 	}
-	return MONTHS_DAYS[month]
+	if rules.DaysIn(year, month) < day {
+		return false
+	}
+
+	c.Year = &year
+	c.Month = &month
+	c.Day = &day
+	return true
 }
 
 func SlashDMY(s rules.Strategy) rules.Rule {
@@ -43,10 +56,6 @@ func SlashDMY(s rules.Strategy) rules.Rule {
 			"((?:1|2)[0-9]{3})\\s*)?" +
 			"(?:\\W|$)"),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if (c.Day != nil || c.Month != nil || c.Year != nil) && s != rules.Override {
-				return false, nil
-			}
-
 			day, _ := strconv.Atoi(m.Captures[0])
 			month, _ := strconv.Atoi(m.Captures[1])
 			year := -1
@@ -58,39 +67,25 @@ func SlashDMY(s rules.Strategy) rules.Rule {
 				return false, nil
 			}
 
-		WithYear:
-			if year != -1 {
-				if getDays(year, month) >= day {
-					c.Year = &year
-					c.Month = &month
-					c.Day = &day
-				} else {
-					return false, nil
-				}
-				return true, nil
-			}
-
-			if int(ref.Month()) > month {
-				year = ref.Year() + 1
-				goto WithYear
+			if !setDate(c, year, month, day, ref) {
+				return o.Invalid("day %d of month %d", day, month)
 			}
 
-			if int(ref.Month()) == month {
-				if getDays(ref.Year(), month) >= day {
-					if day > ref.Day() {
-						year = ref.Year()
-					} else if day < ref.Day() {
-						year = ref.Year() + 1
-					} else {
-						return false, nil
-					}
-					goto WithYear
-				} else {
-					return false, nil
-				}
+			// "3/4" is the 4th of March in the US
+			if day <= 12 && day != month {
+				c.Ambiguous("day/month", 0.6, rules.Alternative{
+					Label:      "month/day",
+					Confidence: 0.4,
+					Apply: func(c *rules.Context) {
+						if c.Month != nil && *c.Month == month && c.Day != nil && *c.Day == day {
+							setDate(c, year, day, month, ref)
+						}
+					},
+				})
 			}
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
@@ -22,6 +22,12 @@ func TestSlashDMY(t *testing.T) {
 		// right after w/o a year
 		{"The Deadline is 28/07", 16, "28/07", (210 - OFFSET) * 24 * time.Hour},
 
+		// a month ahead w/o a year
+		{"The Deadline is 28/09", 16, "28/09", (272 - OFFSET) * 24 * time.Hour},
+
+		// today w/o a year
+		{"The Deadline is 15/07", 16, "15/07", 0},
+
 		// before w/o a year
 		{"The Deadline is 30/06", 16, "30/06", (181 + 366 - OFFSET) * 24 * time.Hour},
 
@@ -36,6 +42,8 @@ func TestSlashDMY(t *testing.T) {
 
 	nilFixt := []Fixture{
 		{"The Deadline is 1/20/2016", 16, "no match for mm/dd/yyyy", 0},
+		{"The Deadline is 29/2/1900", 16, "not a leap year", 0},
+		{"The Deadline is 31/4/2016", 16, "no such day", 0},
 	}
 	ApplyFixturesNil(t, "common.SlashDMY nil", w, nilFixt)
 }
@@ -12,6 +12,85 @@ type Context struct {
 	Year, Month, Weekday, Day, Hour, Minute, Second *int
 
 	Location *time.Location
+
+	// Ambiguities holds the readings of the ambiguous matches, see
+	// Context.Ambiguous
+	Ambiguities []Ambiguity
+
+	// Inferred tells if a rule guessed a part of the time the text does not
+	// say, like the pm of "lunch at 1" with rules.MeridiemInfer set as
+	// Options.Meridiem
+	Inferred bool
+}
+
+// Copy returns a copy of the context not sharing the values with it
+func (c *Context) Copy() Context {
+	cp := *c
+	for _, f := range cp.fields() {
+		if *f != nil {
+			v := **f
+			*f = &v
+		}
+	}
+	cp.Ambiguities = append([]Ambiguity(nil), c.Ambiguities...)
+	return cp
+}
+
+// fields returns the absolute values of the context
+func (c *Context) fields() []**int {
+	return []**int{&c.Year, &c.Month, &c.Weekday, &c.Day, &c.Hour, &c.Minute, &c.Second}
+}
+
+// fieldNames are the names of the fields, in the order of fields
+var fieldNames = []string{"Year", "Month", "Weekday", "Day", "Hour", "Minute", "Second"}
+
+// merge sets the fields of the context a rule wrote in next, a copy of it
+// made before the rule applied and kept in prev, by the strategy of the
+// rule. It returns the names of the fields the rule set, the ones the
+// strategy keeps the value of the context for are left out.
+func (c *Context) merge(next, prev *Context, s Strategy) []string {
+	var set []string
+	switch {
+	case s == Merge:
+		c.Duration += next.Duration
+		if next.Duration != 0 {
+			set = append(set, "Duration")
+		}
+	case next.Duration == prev.Duration:
+	case s == Override, c.Duration == 0:
+		c.Duration = next.Duration
+		set = append(set, "Duration")
+	}
+
+	// the appliers assign the fields, so a written one points elsewhere
+	// than the copy, even if it holds the same value
+	to, from, was := c.fields(), next.fields(), prev.fields()
+	for i := range to {
+		if *from[i] == *was[i] {
+			continue
+		}
+		if s == Override || *to[i] == nil || !changed(*to[i], *from[i]) {
+			*to[i] = *from[i]
+			set = append(set, fieldNames[i])
+		}
+	}
+
+	if next.Location != prev.Location && (s == Override || c.Location == nil || c.Location == next.Location) {
+		c.Location = next.Location
+		set = append(set, "Location")
+	}
+
+	c.Ambiguities = next.Ambiguities
+	c.Inferred = next.Inferred
+	return set
+}
+
+// changed tells if a field has another value
+func changed(a, b *int) bool {
+	if a == nil || b == nil {
+		return a != b
+	}
+	return *a != *b
 }
 
 func (c *Context) Time(t time.Time) (time.Time, error) {
@@ -23,6 +102,13 @@ func (c *Context) Time(t time.Time) (time.Time, error) {
 		t = t.Add(c.Duration)
 	}
 
+	// when the day is set explicitly, move through year and month on the
+	// first day so that e.g. the 31st of the base month doesn't overflow
+	if c.Day != nil && (c.Year != nil || c.Month != nil) {
+		t = time.Date(t.Year(), t.Month(), 1, t.Hour(),
+			t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	}
+
 	if c.Year != nil {
 		t = time.Date(*c.Year, t.Month(), t.Day(), t.Hour(),
 			t.Minute(), t.Second(), t.Nanosecond(), t.Location())
@@ -0,0 +1,62 @@
+package de
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"heute" -> today
+	"übermorgen" -> the day after tomorrow
+	"vorgestern" -> the day before yesterday
+	"morgen früh", "morgenfrüh" -> 8:00 tomorrow
+	"gestern Abend" -> 18:00 yesterday
+	"heute Nacht" -> 23:00 today
+
+	"morgen" is tomorrow unless it follows "am", "guten", "diesen" or
+	"jeden", then it is the morning.
+*/
+
+func CasualDate(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"((?:am|guten|diesen|jeden)\\s+)?" +
+			"(jetzt|sofort|heute|übermorgen|morgen|vorgestern|gestern|letzte\\s+nacht)" +
+			"(?:\\s*(" + DAY_PART_PATTERN + "))?" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			day := strings.Join(strings.Fields(strings.ToLower(m.Captures[1])), " ")
+			part := m.Captures[2]
+
+			if day == "morgen" && m.Captures[0] != "" && part == "" {
+				return false, nil
+			}
+
+			switch day {
+			case "morgen":
+				c.Duration = 24 * time.Hour
+			case "übermorgen":
+				c.Duration = 2 * 24 * time.Hour
+			case "gestern":
+				c.Duration = -(24 * time.Hour)
+			case "vorgestern":
+				c.Duration = -(2 * 24 * time.Hour)
+			case "letzte nacht":
+				c.Duration = -(24 * time.Hour)
+				part = "nacht"
+			}
+
+			if hour, ok := dayPartHour(part, o); ok {
+				c.Hour = &hour
+				c.Minute = pointer.ToInt(0)
+			}
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
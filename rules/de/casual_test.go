@@ -0,0 +1,97 @@
+package de_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/de"
+)
+
+func TestCasualDate(t *testing.T) {
+	fixt := []Fixture{
+		{"Die Frist ist jetzt, ok", 14, "jetzt", 0},
+		{"Die Frist ist heute", 14, "heute", 0},
+		{"Die Frist ist morgen", 14, "morgen", 24 * time.Hour},
+		{"Die Frist ist übermorgen", 14, "übermorgen", 2 * 24 * time.Hour},
+		{"Die Frist war gestern", 14, "gestern", -(24 * time.Hour)},
+		{"Die Frist war vorgestern", 14, "vorgestern", -(2 * 24 * time.Hour)},
+		{"Die Frist ist heute Abend", 14, "heute Abend", 18 * time.Hour},
+		{"Die Frist ist heute Nacht", 14, "heute Nacht", 23 * time.Hour},
+		{"Die Frist ist morgen früh", 14, "morgen früh", (24 + 8) * time.Hour},
+		{"Die Frist ist morgenfrüh", 14, "morgenfrüh", (24 + 8) * time.Hour},
+		{"Die Frist ist morgen Nachmittag", 14, "morgen Nachmittag", (24 + 15) * time.Hour},
+		{"Die Frist war gestern Abend", 14, "gestern Abend", -(6 * time.Hour)},
+		{"Die Frist war letzte Nacht", 14, "letzte Nacht", -(time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(de.CasualDate(rules.Skip))
+
+	ApplyFixtures(t, "de.CasualDate", w, fixt)
+
+	fixtnil := []Fixture{
+		{"Guten Morgen", 0, "", 0},
+		{"am Morgen", 0, "", 0},
+		{"Morgenstern", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "de.CasualDate nil", w, fixtnil)
+}
+
+func TestCasualTime(t *testing.T) {
+	fixt := []Fixture{
+		{"Die Frist war am Morgen", 14, "am Morgen", 8 * time.Hour},
+		{"Die Frist war heute Morgen", 14, "heute Morgen", 8 * time.Hour},
+		{"Die Frist ist am Vormittag", 14, "am Vormittag", 10 * time.Hour},
+		{"Die Frist ist nachmittags", 14, "nachmittags", 15 * time.Hour},
+		{"Die Frist ist gegen Abend", 14, "gegen Abend", 18 * time.Hour},
+		{"Die Frist ist in der Nacht", 14, "in der Nacht", 23 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(de.CasualTime(rules.Skip))
+
+	ApplyFixtures(t, "de.CasualTime", w, fixt)
+
+	fixtnil := []Fixture{
+		{"bis morgen", 0, "", 0},
+		{"zu früh", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "de.CasualTime nil", w, fixtnil)
+}
+
+func TestCasualDateCasualTime(t *testing.T) {
+	fixt := []Fixture{
+		{"Die Frist ist morgen Abend", 14, "morgen Abend", (24 + 18) * time.Hour},
+		{"Die Frist ist morgen am Vormittag", 14, "morgen am Vormittag", (24 + 10) * time.Hour},
+		{"Die Frist war gestern in der Nacht", 14, "gestern in der Nacht", -(time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(
+		de.CasualDate(rules.Skip),
+		de.CasualTime(rules.Override),
+	)
+
+	ApplyFixtures(t, "de.CasualDate|de.CasualTime", w, fixt)
+}
+
+func TestMidnight(t *testing.T) {
+	fixt := []Fixture{
+		{"um Mitternacht", 0, "um Mitternacht", 24 * time.Hour},
+		{"heute Nacht um Mitternacht", 0, "heute Nacht um Mitternacht", 24 * time.Hour},
+		{"morgen zu Mittag", 0, "morgen zu Mittag", (24 + 12) * time.Hour},
+		{"mittags", 0, "mittags", 12 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(
+		de.CasualDate(rules.Skip),
+		de.Midnight(rules.Override),
+	)
+
+	ApplyFixtures(t, "de.Midnight", w, fixt)
+}
@@ -0,0 +1,42 @@
+package de
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"am Morgen" -> 8:00
+	"heute Vormittag" -> 10:00
+	"nachmittags" -> 15:00
+	"gegen Abend" -> 18:00
+	"in der Nacht" -> 23:00
+
+	A bare "morgen" or "früh" is left to the casual date, it is tomorrow or
+	just early there.
+*/
+
+func CasualTime(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"(?:((?:am|gegen|heute|diese[nmr]?|in\\s+der)\\s+)" +
+			"(früh|morgens?|vormittags?|nachmittags?|abends?|nachts?)" +
+			"|(morgens|vormittags?|nachmittags?|abends?|nachts?))" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			hour, ok := dayPartHour(m.Captures[1]+m.Captures[2], o)
+			if !ok {
+				return false, nil
+			}
+
+			c.Hour = &hour
+			c.Minute = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,164 @@
+package de
+
+import (
+	_ "embed"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/olebedev/when/rules/locale"
+	"github.com/pkg/errors"
+)
+
+var describe = rules.Describer("de")
+
+//go:embed de.json
+var vocabulary []byte
+
+// DATA holds the words of the language, see Extend
+var DATA = locale.MustLoad(vocabulary)
+
+var All []rules.Rule
+
+// the patterns of the tables, see build
+var (
+	WEEKDAY_OFFSET_PATTERN string
+	MONTH_OFFSET_PATTERN   string
+	INTEGER_WORDS_PATTERN  string
+	ORDINAL_WORDS_PATTERN  string
+)
+
+func init() {
+	build()
+}
+
+// Extend adds the words of ext to the language and rebuilds All, the
+// parsers made before keep the rules they have. Call it before making the
+// parsers, it isn't safe for concurrent use.
+func Extend(ext *locale.Data) {
+	DATA.Extend(ext)
+	build()
+}
+
+// build makes the patterns of the tables and the rules using them
+func build() {
+	WEEKDAY_OFFSET_PATTERN = locale.Pattern(WEEKDAY_OFFSET)
+	MONTH_OFFSET_PATTERN = locale.Pattern(MONTH_OFFSET)
+	INTEGER_WORDS_PATTERN = locale.Pattern(INTEGER_WORDS)
+	ORDINAL_WORDS_PATTERN = `(?:` + locale.Pattern(ORDINAL_WORDS) + `e[nmrs]?)`
+	AMOUNT_PATTERN = `(?:[0-9]+(?:[.,][0-9]+)?|` + INTEGER_WORDS_PATTERN +
+		`|(?:ein(?:e[mnrs]?)?\s+)?halbe[mnrs]?|einem|einen|einer|eines|anderthalb|eineinhalb|ein\s+paar|einige[mnr]?|wenige[mnr]?|mehrere[mnr]?)`
+
+	STOPS = []*regexp.Regexp{
+		rules.Labeled(LABELS...),
+		// amounts something changes by, "um 8 Prozent", "um zwei Wochen"
+		regexp.MustCompile(`(?i)(?:^|[^\pL])um\s+(` + AMOUNT_PATTERN + `)\s*` +
+			`(?:%|prozent|euro|€|grad|punkte?|meter|` + UNIT_PATTERN[3:] + `(?:[^\pL]|$)`),
+	}
+
+	All = []rules.Rule{
+		describe("weekday", rules.Date, 70, Weekday(rules.Override)),
+		describe("relative_week", rules.Range, 70, RelativeWeek(rules.Override)),
+		describe("casual_date", rules.Date, 60, CasualDate(rules.Override)),
+		describe("casual_time", rules.Time, 60, CasualTime(rules.Override)),
+		describe("midnight", rules.Time, 60, Midnight(rules.Override)),
+		describe("military_time", rules.Time, 50, common.MilitaryTime(rules.Override)),
+		describe("hour", rules.Time, 50, Hour(rules.Override)),
+		describe("hour_minute", rules.Time, 50, HourMinute(rules.Override)),
+		describe("hour_relative", rules.Time, 50, HourRelative(rules.Override)),
+		describe("deadline", rules.Duration, 40, Deadline(rules.Override)),
+		describe("past_time", rules.Duration, 40, PastTime(rules.Override)),
+		describe("exact_month_date", rules.Date, 30, ExactMonthDate(rules.Override)),
+	}
+}
+
+// CONNECTORS holds the words joining the parts of a time, like "um" of
+// "morgen, nach dem Treffen, um 15 Uhr", see rules.ClusterByTokens
+var CONNECTORS = []string{"um", "am", "an", "im", "in", "der", "die", "den", "des", "gegen", "ab"}
+
+// LABELS are the words labeling the numbers of the things, like "Zimmer" of
+// "Zimmer 1200", see STOPS
+var LABELS = []string{
+	"zimmer", "raum", "version", "nr", "nummer", "bestellung", "flug",
+	"ticket", "seite", "kapitel", "abschnitt", "gleis",
+}
+
+// STOPS keep the numbers of the things from being read as a time or a date,
+// like "Zimmer 1200" or "Version 2.0", see Parser.Stop of the when package
+var STOPS []*regexp.Regexp
+
+// the tables of the language, from de.json
+var (
+	WEEKDAY_OFFSET = DATA.Weekdays
+	MONTH_OFFSET   = DATA.Months
+	INTEGER_WORDS  = DATA.Numerals
+	// ORDINAL_WORDS holds the stems of the ordinals, the inflected forms
+	// like "erste", "ersten" or "erster" add an ending to them
+	ORDINAL_WORDS = DATA.Ordinals
+)
+
+// DAY_PART_PATTERN matches the parts of the day, also in their adverbial
+// form like "abends"
+var DAY_PART_PATTERN = `(?:früh|morgens?|vormittags?|mittags?|nachmittags?|abends?|nachts?)`
+
+// CLOCK_DAY_PART_PATTERN matches the parts of the day after a time, a bare
+// "morgen" there is tomorrow, not the morning: "5 Uhr morgen"
+var CLOCK_DAY_PART_PATTERN = `(?:früh|morgens|am\s+morgen|vormittags?|mittags?|nachmittags?|abends?|nachts?)`
+
+// dayPartHour returns the hour a part of the day like "Abend" refers to
+func dayPartHour(part string, o *rules.Options) (int, bool) {
+	switch strings.TrimSuffix(strings.ToLower(strings.TrimSpace(part)), "s") {
+	case "früh", "morgen":
+		if o.Morning != 0 {
+			return o.Morning, true
+		}
+		return 8, true
+	case "vormittag":
+		return 10, true
+	case "mittag":
+		if o.Noon != 0 {
+			return o.Noon, true
+		}
+		return 12, true
+	case "nachmittag":
+		if o.Afternoon != 0 {
+			return o.Afternoon, true
+		}
+		return 15, true
+	case "abend":
+		if o.Evening != 0 {
+			return o.Evening, true
+		}
+		return 18, true
+	case "nacht":
+		return 23, true
+	}
+	return 0, false
+}
+
+// parseInteger converts a digit or spelled-out integer to its value
+func parseInteger(s string) (int, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if n, ok := INTEGER_WORDS[s]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, errors.Wrapf(err, "convert '%s' to int", s)
+	}
+	return n, nil
+}
+
+// parseOrdinal converts an inflected ordinal like "dritten" to its value
+func parseOrdinal(s string) (int, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	for _, ending := range []string{"en", "em", "er", "es", "e"} {
+		if n, ok := ORDINAL_WORDS[strings.TrimSuffix(s, ending)]; ok && strings.HasSuffix(s, ending) {
+			return n, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,64 @@
+package de_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules/de"
+	"github.com/stretchr/testify/require"
+)
+
+var null = time.Date(2016, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+type Fixture struct {
+	Text   string
+	Index  int
+	Phrase string
+	Diff   time.Duration
+}
+
+func ApplyFixtures(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d", name, i)
+		require.NotNil(t, res, "[%s] res #%d", name, i)
+		require.Equal(t, f.Index, res.Index, "[%s] index #%d", name, i)
+		require.Equal(t, f.Phrase, res.Text, "[%s] text #%d", name, i)
+		require.Equal(t, f.Diff, res.Time.Sub(null), "[%s] diff #%d", name, i)
+	}
+}
+
+func ApplyFixturesNil(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d", name, i)
+		require.Nil(t, res, "[%s] res #%d", name, i)
+	}
+}
+
+func TestAll(t *testing.T) {
+	w := when.New(nil)
+	w.Add(de.All...)
+
+	// complex cases
+	fixt := []Fixture{
+		{"nächsten Dienstag um 14:00", 0, "nächsten Dienstag um 14:00", ((6 * 24) + 14) * time.Hour},
+		{"am Montagabend um halb acht", 3, "Montagabend um halb acht", ((5*24)+19)*time.Hour + 30*time.Minute},
+		{"übermorgen um 10 Uhr", 0, "übermorgen um 10 Uhr", ((2 * 24) + 10) * time.Hour},
+		{"morgen früh um 7:15", 0, "morgen früh um 7:15", (24+7)*time.Hour + 15*time.Minute},
+		{"heute Abend um Viertel nach acht", 0, "heute Abend um Viertel nach acht", 20*time.Hour + 15*time.Minute},
+		{"letzten Freitag um 15 Uhr", 0, "letzten Freitag um 15 Uhr", ((-5 * 24) + 15) * time.Hour},
+		{"Treffen am 5. März um 9 Uhr", 11, "5. März um 9 Uhr", (59*24 + 9) * time.Hour},
+		{"Freitag nächster Woche um 16:30", 0, "Freitag nächster Woche um 16:30", (9*24+16)*time.Hour + 30*time.Minute},
+		{"gestern um Mitternacht", 0, "gestern um Mitternacht", 0},
+		{"um 5 Uhr morgen", 3, "5 Uhr morgen", (24 + 5) * time.Hour},
+		{"17:30 morgen", 0, "17:30 morgen", (24+17)*time.Hour + 30*time.Minute},
+		{"morgen um 8", 0, "morgen um 8", (24 + 8) * time.Hour},
+		{"heute Abend um 8", 0, "heute Abend um 8", 20 * time.Hour},
+		{"am Montagabend um 8", 3, "Montagabend um 8", (5*24 + 20) * time.Hour},
+		{"morgen früh um sieben", 0, "morgen früh um sieben", (24 + 7) * time.Hour},
+	}
+
+	ApplyFixtures(t, "de.All...", w, fixt)
+}
@@ -0,0 +1,37 @@
+package de
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/pkg/errors"
+)
+
+/*
+	"in 5 Minuten"
+	"in einer Stunde"
+	"in einer halben Stunde"
+	"innerhalb von 2 Tagen"
+	"binnen einer Woche"
+	"in drei Monaten"
+*/
+
+func Deadline(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"((?:in|innerhalb(?:\\s+von)?|binnen)\\s+" +
+			"(" + AMOUNT_PATTERN + ")\\s*" +
+			"(" + UNIT_PATTERN + "))" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			amount, err := parseAmount(m.Captures[1])
+			if err != nil {
+				return false, errors.Wrap(err, "deadline rule")
+			}
+
+			return applyAmount(c, ref, amount, m.Captures[2]), nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,43 @@
+package de_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/de"
+)
+
+func TestDeadline(t *testing.T) {
+	fixt := []Fixture{
+		{"in einer halben Stunde", 0, "in einer halben Stunde", time.Hour / 2},
+		{"in einer Stunde", 0, "in einer Stunde", time.Hour},
+		{"in 5 Minuten", 0, "in 5 Minuten", 5 * time.Minute},
+		{"In 5 Minuten gehe ich nach Hause", 0, "In 5 Minuten", 5 * time.Minute},
+		{"wir müssen innerhalb von 10 Tagen etwas tun", 12, "innerhalb von 10 Tagen", 10 * 24 * time.Hour},
+		{"wir müssen binnen fünf Tagen etwas tun", 12, "binnen fünf Tagen", 5 * 24 * time.Hour},
+		{"in 30 Sekunden", 0, "in 30 Sekunden", 30 * time.Second},
+		{"in einer Viertelstunde", 0, "in einer Viertelstunde", 15 * time.Minute},
+		{"in anderthalb Stunden", 0, "in anderthalb Stunden", 90 * time.Minute},
+		{"in 2,5 Tagen", 0, "in 2,5 Tagen", 60 * time.Hour},
+		{"in zwei Wochen", 0, "in zwei Wochen", 14 * 24 * time.Hour},
+		{"in einer Woche", 0, "in einer Woche", 7 * 24 * time.Hour},
+		{"in einem Monat", 0, "in einem Monat", 31 * 24 * time.Hour},
+		{"in ein paar Monaten", 0, "in ein paar Monaten", 91 * 24 * time.Hour},
+		{"in einem Jahr", 0, "in einem Jahr", 366 * 24 * time.Hour},
+		{"innerhalb eines halben Jahres", 0, "innerhalb eines halben Jahres", 182 * 24 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(de.Deadline(rules.Skip))
+
+	ApplyFixtures(t, "de.Deadline", w, fixt)
+
+	fixtnil := []Fixture{
+		{"in 2016", 0, "", 0},
+		{"in Stunden", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "de.Deadline nil", w, fixtnil)
+}
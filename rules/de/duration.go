@@ -0,0 +1,112 @@
+package de
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/pkg/errors"
+)
+
+/*
+	Amounts and units of the relative rules:
+	- "5 Minuten"
+	- "einer Stunde"
+	- "einer halben Stunde"
+	- "einer Viertelstunde"
+	- "anderthalb Tagen"
+	- "ein paar Wochen"
+	- "2,5 Jahren"
+*/
+
+// AMOUNT_PATTERN matches the amounts of the durations, in digits or words,
+// see build
+var AMOUNT_PATTERN string
+
+var UNIT_PATTERN = `(?:sekunden?|sek\.?|minuten?|min\.?|dreiviertelstunden?|viertelstunden?|stunden?|std\.?|tag(?:e[ns]?)?|wochen?|monat(?:en?|s)?|jahr(?:e[ns]?)?)`
+
+// parseAmount converts an amount matched by AMOUNT_PATTERN to its value
+func parseAmount(s string) (float64, error) {
+	s = strings.ToLower(strings.Join(strings.Fields(s), " "))
+
+	switch {
+	case s == "anderthalb", s == "eineinhalb":
+		return 1.5, nil
+	case strings.Contains(s, "halb"):
+		return 0.5, nil
+	case strings.HasPrefix(s, "ein "), strings.HasPrefix(s, "einig"),
+		strings.HasPrefix(s, "wenig"), strings.HasPrefix(s, "mehrer"):
+		return 3, nil
+	case strings.HasPrefix(s, "ein"):
+		return 1, nil
+	}
+
+	if n, ok := INTEGER_WORDS[s]; ok {
+		return float64(n), nil
+	}
+
+	n, err := strconv.ParseFloat(strings.Replace(s, ",", ".", 1), 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "convert '%s' to number", s)
+	}
+	return n, nil
+}
+
+// applyAmount sets the context to the amount of the unit from ref, months
+// and years move through the calendar
+func applyAmount(c *rules.Context, ref time.Time, amount float64, unit string) bool {
+	unit = strings.ToLower(unit)
+
+	var duration time.Duration
+	switch {
+	case strings.HasPrefix(unit, "sek"):
+		duration = time.Second
+	case strings.HasPrefix(unit, "min"):
+		duration = time.Minute
+	case strings.HasPrefix(unit, "dreiviertel"):
+		duration = 45 * time.Minute
+	case strings.HasPrefix(unit, "viertel"):
+		duration = 15 * time.Minute
+	case strings.HasPrefix(unit, "st"):
+		duration = time.Hour
+	case strings.HasPrefix(unit, "tag"):
+		duration = 24 * time.Hour
+	case strings.HasPrefix(unit, "woche"):
+		duration = 7 * 24 * time.Hour
+	case strings.HasPrefix(unit, "monat"), strings.HasPrefix(unit, "jahr"):
+		months := amount
+		if strings.HasPrefix(unit, "jahr") {
+			months *= 12
+		}
+		whole, fraction := math.Modf(months)
+		shiftMonths(c, ref, int(whole))
+		if fraction != 0 {
+			c.Duration = time.Duration(fraction * float64(30*24*time.Hour))
+		}
+		return true
+	default:
+		return false
+	}
+
+	c.Duration = time.Duration(amount * float64(duration))
+	return true
+}
+
+// shiftMonths sets the year and month fields to the given number of months
+// from ref
+func shiftMonths(c *rules.Context, ref time.Time, months int) {
+	month := int(ref.Month()) - 1 + months
+	year := ref.Year() + month/12
+	if month %= 12; month < 0 {
+		month += 12
+		year--
+	}
+
+	if year != ref.Year() {
+		c.Year = &year
+	}
+	month++
+	c.Month = &month
+}
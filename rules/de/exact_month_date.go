@@ -0,0 +1,72 @@
+package de
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+)
+
+/*
+	"5. März"
+	"am 5. März 2017"
+	"dritten März"
+	"1 Sept."
+	"März"
+*/
+
+// 1. - numeric day?
+// 2. - ordinal day?
+// 3. - month
+// 4. - year?
+
+func ExactMonthDate(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:[^\\p{L}\\p{N}]|^)" +
+			"(?:(?:am|vom|zum|bis\\s+zum)\\s+)?" +
+			"(?:([0-9]{1,2})\\.?\\s*|(" + ORDINAL_WORDS_PATTERN[3:] + "\\s+)?" +
+			"(" + MONTH_OFFSET_PATTERN[3:] +
+			"(?:\\s+([0-9]{4}))?" +
+			"(?:[^\\p{L}\\p{N}]|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			month, ok := MONTH_OFFSET[strings.ToLower(m.Captures[2])]
+			if !ok {
+				return false, nil
+			}
+
+			day := 0
+			if m.Captures[0] != "" {
+				n, err := strconv.Atoi(m.Captures[0])
+				if err != nil || n < 1 || n > 31 {
+					return false, nil
+				}
+				day = n
+			}
+			if m.Captures[1] != "" {
+				if day, ok = parseOrdinal(m.Captures[1]); !ok {
+					return false, nil
+				}
+			}
+
+			if m.Captures[3] != "" {
+				year, err := strconv.Atoi(m.Captures[3])
+				if err != nil {
+					return false, nil
+				}
+				c.Year = &year
+				common.ForgetYear(c, year)
+			}
+
+			c.Month = &month
+			if day != 0 {
+				c.Day = &day
+			}
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,38 @@
+package de_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/de"
+)
+
+func TestExactMonthDate(t *testing.T) {
+	w := when.New(nil)
+	w.Add(de.ExactMonthDate(rules.Override))
+
+	fixt := []Fixture{
+		{"5. März", 0, "5. März", 59 * 24 * time.Hour},
+		{"am 5. März", 3, "5. März", 59 * 24 * time.Hour},
+		{"5.März", 0, "5.März", 59 * 24 * time.Hour},
+		{"5 März", 0, "5 März", 59 * 24 * time.Hour},
+		{"am dritten März", 3, "dritten März", 57 * 24 * time.Hour},
+		{"erster Februar", 0, "erster Februar", 26 * 24 * time.Hour},
+		{"1 Sept.", 0, "1 Sept.", 239 * 24 * time.Hour},
+		{"20. Dezember 2017", 0, "20. Dezember 2017", 714 * 24 * time.Hour},
+		{"Februar", 0, "Februar", 31 * 24 * time.Hour},
+		{"MÄRZ", 0, "MÄRZ", 60 * 24 * time.Hour},
+		{"Jänner", 0, "Jänner", 0},
+	}
+
+	ApplyFixtures(t, "de.ExactMonthDate", w, fixt)
+
+	fixtnil := []Fixture{
+		{"Maier", 0, "", 0},
+		{"5. Marzipan", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "de.ExactMonthDate nil", w, fixtnil)
+}
@@ -0,0 +1,85 @@
+package de
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/pkg/errors"
+)
+
+/*
+	"15 Uhr"
+	"um 8 Uhr"
+	"um 8", "gegen acht"
+	"drei Uhr nachmittags"
+	"10 Uhr abends"
+	"2 Uhr in der Nacht"
+*/
+
+// 1. - hour said with "Uhr"
+// 2. - "Uhr"
+// 3. - hour said after "um" or "gegen" without "Uhr"
+// 4. - "am" or "in der"?
+// 5. - part of the day?
+
+func Hour(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:[^\\p{L}\\p{N}]|^)" +
+			"(?:(?:(?:um|gegen|ab|bis)\\s+)?" +
+			"([0-9]{1,2}|" + INTEGER_WORDS_PATTERN[3:] + "\\s*(uhr)|" +
+			"(?:um|gegen)\\s+([0-9]{1,2}|" + INTEGER_WORDS_PATTERN[3:] + ")" +
+			"(?:\\s+((?:am|in\\s+der)\\s+)?(" + CLOCK_DAY_PART_PATTERN + "))?" +
+			"(?:[^\\p{L}\\p{N}]|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			hour, err := parseInteger(m.Captures[0] + m.Captures[2])
+			if err != nil {
+				return false, errors.Wrap(err, "hour rule")
+			}
+
+			if hour > 23 {
+				return false, nil
+			}
+
+			hour = dayPartClock(hour, m.Captures[4], c.Text, o)
+
+			zero := 0
+			c.Hour = &hour
+			c.Minute = &zero
+			c.Second = &zero
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
+
+// dayPartClock turns the hour of a 12-hour clock to the one of the part of
+// the day it is said in, "8 Uhr abends" is 20:00. Without a part of its own
+// the time takes the one of the surrounding text, as in "Montagabend um 8".
+func dayPartClock(hour int, part, text string, o *rules.Options) int {
+	if part == "" {
+		part = dayPartRegExp.FindString(text)
+	}
+
+	part = strings.TrimSuffix(strings.ToLower(part), "s")
+
+	switch part {
+	case "mittag":
+		if hour >= 1 && hour < 6 {
+			hour += 12
+		}
+	case "nachmittag", "abend":
+		if hour < 12 {
+			hour += 12
+		}
+	case "nacht":
+		hour = common.Night.Clock(hour, o)
+	}
+
+	return hour
+}
+
+var dayPartRegExp = regexp.MustCompile(`(?i)(?:vormittag|nachmittag|mittag|abend|nacht)`)
@@ -0,0 +1,62 @@
+package de
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/pkg/errors"
+)
+
+/*
+	"14:30"
+	"um 14:30 Uhr"
+	"14.30 Uhr"
+	"8 Uhr 15"
+	"7:45 abends"
+*/
+
+// 1. - hour
+// 2. - minutes after a colon?
+// 3. - "Uhr"?
+// 4. - minutes after a dot?
+// 5. - "Uhr"
+// 6. - "Uhr"
+// 7. - minutes after "Uhr"?
+// 8. - "am" or "in der"?
+// 9. - part of the day?
+
+func HourMinute(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:[^\\p{L}\\p{N}]|^)" +
+			"(?:(?:um|gegen|ab|bis)\\s+)?" +
+			"([01]?[0-9]|2[0-3])" +
+			"(?:[:：]([0-5][0-9])(?:\\s*(uhr))?" +
+			"|\\.([0-5][0-9])\\s*(uhr)" +
+			"|\\s*(uhr)\\s+([0-5]?[0-9]))" +
+			"(?:\\s+((?:am|in\\s+der)\\s+)?(" + CLOCK_DAY_PART_PATTERN + "))?" +
+			"(?:[^\\p{L}\\p{N}]|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			hour, err := strconv.Atoi(m.Captures[0])
+			if err != nil {
+				return false, errors.Wrap(err, "hour minute rule")
+			}
+
+			minutes, err := strconv.Atoi(m.Captures[1] + m.Captures[3] + m.Captures[6])
+			if err != nil {
+				return false, errors.Wrap(err, "hour minute rule")
+			}
+
+			hour = dayPartClock(hour, m.Captures[8], c.Text, o)
+
+			seconds := 0
+			c.Hour = &hour
+			c.Minute = &minutes
+			c.Second = &seconds
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,34 @@
+package de_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/de"
+)
+
+func TestHourMinute(t *testing.T) {
+	fixt := []Fixture{
+		{"14:30", 0, "14:30", 14*time.Hour + 30*time.Minute},
+		{"um 14:30 Uhr", 3, "14:30 Uhr", 14*time.Hour + 30*time.Minute},
+		{"14.30 Uhr", 0, "14.30 Uhr", 14*time.Hour + 30*time.Minute},
+		{"8 Uhr 15", 0, "8 Uhr 15", 8*time.Hour + 15*time.Minute},
+		{"7:45 abends", 0, "7:45 abends", 19*time.Hour + 45*time.Minute},
+		{"gegen 9:05 am Vormittag", 6, "9:05 am Vormittag", 9*time.Hour + 5*time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Add(de.HourMinute(rules.Override))
+
+	ApplyFixtures(t, "de.HourMinute", w, fixt)
+
+	fixtnil := []Fixture{
+		{"5.03.2016", 0, "", 0},
+		{"14.30", 0, "", 0},
+		{"25:10", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "de.HourMinute nil", w, fixtnil)
+}
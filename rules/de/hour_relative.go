@@ -0,0 +1,100 @@
+package de
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/pkg/errors"
+)
+
+/*
+	"halb drei" -> 2:30
+	"Viertel nach drei" -> 3:15
+	"Viertel vor drei", "dreiviertel drei" -> 2:45
+	"Viertel drei" -> 2:15
+	"zehn nach acht" -> 8:10
+	"fünf vor halb drei" -> 2:25
+	"fünf nach halb drei" -> 2:35
+	"halb acht abends" -> 19:30
+*/
+
+// 1. - minutes or "Viertel" before "nach" or "vor"?
+// 2. - "nach" or "vor"?
+// 3. - "halb" after "nach" or "vor"?
+// 4. - "halb", "Viertel" or "dreiviertel"?
+// 5. - hour
+// 6. - "Uhr"?
+// 7. - "am" or "in der"?
+// 8. - part of the day?
+
+func HourRelative(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:[^\\p{L}\\p{N}]|^)" +
+			"(?:(?:um|gegen|ab|bis)\\s+)?" +
+			"(?:(viertel|[0-9]{1,2}|" + INTEGER_WORDS_PATTERN[3:] + "\\s+(nach|vor)\\s+(?:(halb)\\s+)?" +
+			"|(halb|dreiviertel|drei\\s*viertel|viertel)\\s+)" +
+			"([0-9]{1,2}|" + INTEGER_WORDS_PATTERN[3:] +
+			"(?:\\s*(uhr))?" +
+			"(?:\\s+((?:am|in\\s+der)\\s+)?(" + CLOCK_DAY_PART_PATTERN + "))?" +
+			"(?:[^\\p{L}\\p{N}]|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			hour, err := parseInteger(m.Captures[4])
+			if err != nil {
+				return false, errors.Wrap(err, "hour relative rule")
+			}
+			if hour > 24 {
+				return false, nil
+			}
+
+			// the time in minutes, the half and quarters refer to the hour
+			// to come: "halb drei" is half way to three
+			total := hour * 60
+			switch strings.Join(strings.Fields(strings.ToLower(m.Captures[3])), "") {
+			case "halb":
+				total -= 30
+			case "dreiviertel":
+				total -= 15
+			case "viertel":
+				total -= 45
+			default:
+				minutes := 15
+				if strings.ToLower(m.Captures[0]) != "viertel" {
+					minutes, err = parseInteger(m.Captures[0])
+					if err != nil {
+						return false, errors.Wrap(err, "hour relative rule")
+					}
+				}
+				if minutes >= 30 {
+					return false, nil
+				}
+
+				if m.Captures[2] != "" {
+					total -= 30
+				}
+				if strings.ToLower(m.Captures[1]) == "vor" {
+					total -= minutes
+				} else {
+					total += minutes
+				}
+			}
+
+			if total < 0 {
+				total += 24 * 60
+			}
+			hour, minutes := total/60%24, total%60
+			if hour != 0 {
+				hour = dayPartClock(hour, m.Captures[7], c.Text, o)
+			}
+
+			seconds := 0
+			c.Hour = &hour
+			c.Minute = &minutes
+			c.Second = &seconds
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,43 @@
+package de_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/de"
+)
+
+func TestHourRelative(t *testing.T) {
+	fixt := []Fixture{
+		{"halb drei", 0, "halb drei", 2*time.Hour + 30*time.Minute},
+		{"um halb 8", 3, "halb 8", 7*time.Hour + 30*time.Minute},
+		{"halb eins", 0, "halb eins", 30 * time.Minute},
+		{"Viertel nach drei", 0, "Viertel nach drei", 3*time.Hour + 15*time.Minute},
+		{"Viertel vor drei", 0, "Viertel vor drei", 2*time.Hour + 45*time.Minute},
+		{"dreiviertel drei", 0, "dreiviertel drei", 2*time.Hour + 45*time.Minute},
+		{"drei viertel drei", 0, "drei viertel drei", 2*time.Hour + 45*time.Minute},
+		{"Viertel drei", 0, "Viertel drei", 2*time.Hour + 15*time.Minute},
+		{"zehn nach acht", 0, "zehn nach acht", 8*time.Hour + 10*time.Minute},
+		{"20 vor 5", 0, "20 vor 5", 4*time.Hour + 40*time.Minute},
+		{"fünf vor halb drei", 0, "fünf vor halb drei", 2*time.Hour + 25*time.Minute},
+		{"fünf nach halb drei", 0, "fünf nach halb drei", 2*time.Hour + 35*time.Minute},
+		{"halb acht abends", 0, "halb acht abends", 19*time.Hour + 30*time.Minute},
+		{"Viertel nach zwei nachmittags", 0, "Viertel nach zwei nachmittags", 14*time.Hour + 15*time.Minute},
+		{"um Viertel nach 9 Uhr", 3, "Viertel nach 9 Uhr", 9*time.Hour + 15*time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Add(de.HourRelative(rules.Override))
+
+	ApplyFixtures(t, "de.HourRelative", w, fixt)
+
+	fixtnil := []Fixture{
+		{"halbe Stunde", 0, "", 0},
+		{"nach drei", 0, "", 0},
+		{"40 nach drei", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "de.HourRelative nil", w, fixtnil)
+}
@@ -0,0 +1,42 @@
+package de_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/de"
+)
+
+func TestHour(t *testing.T) {
+	fixt := []Fixture{
+		{"15 Uhr", 0, "15 Uhr", 15 * time.Hour},
+		{"um 8 Uhr", 3, "8 Uhr", 8 * time.Hour},
+		{"um acht Uhr", 3, "acht Uhr", 8 * time.Hour},
+		{"drei Uhr nachmittags", 0, "drei Uhr nachmittags", 15 * time.Hour},
+		{"10 Uhr abends", 0, "10 Uhr abends", 22 * time.Hour},
+		{"2 Uhr in der Nacht", 0, "2 Uhr in der Nacht", 2 * time.Hour},
+		{"10 Uhr nachts", 0, "10 Uhr nachts", 22 * time.Hour},
+		{"12 Uhr nachts", 0, "12 Uhr nachts", 24 * time.Hour},
+		{"1 Uhr mittags", 0, "1 Uhr mittags", 13 * time.Hour},
+		{"9 Uhr am Morgen", 0, "9 Uhr am Morgen", 9 * time.Hour},
+		{"um 8", 3, "8", 8 * time.Hour},
+		{"gegen acht abends", 6, "acht abends", 20 * time.Hour},
+		{"Treffen um 15.", 11, "15", 15 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(de.Hour(rules.Override))
+
+	ApplyFixtures(t, "de.Hour", w, fixt)
+
+	fixtnil := []Fixture{
+		{"25 Uhr", 0, "", 0},
+		{"Uhrzeit", 0, "", 0},
+		{"ab 8", 0, "", 0},
+		{"um 25", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "de.Hour nil", w, fixtnil)
+}
@@ -0,0 +1,36 @@
+package de
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/pkg/errors"
+)
+
+/*
+	"vor 5 Minuten"
+	"vor einer Stunde"
+	"vor einer halben Stunde"
+	"vor zwei Tagen"
+	"vor einem Jahr"
+*/
+
+func PastTime(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"(vor\\s+" +
+			"(" + AMOUNT_PATTERN + ")\\s*" +
+			"(" + UNIT_PATTERN + "))" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			amount, err := parseAmount(m.Captures[1])
+			if err != nil {
+				return false, errors.Wrap(err, "past time rule")
+			}
+
+			return applyAmount(c, ref, -amount, m.Captures[2]), nil
+		},
+		Strategy: s,
+	}
+}
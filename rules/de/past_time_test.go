@@ -0,0 +1,35 @@
+package de_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/de"
+)
+
+func TestPastTime(t *testing.T) {
+	fixt := []Fixture{
+		{"vor einer halben Stunde", 0, "vor einer halben Stunde", -(time.Hour / 2)},
+		{"vor einer Stunde", 0, "vor einer Stunde", -(time.Hour)},
+		{"vor 5 Minuten", 0, "vor 5 Minuten", -(5 * time.Minute)},
+		{"Vor 5 Minuten ging ich zum Zahnarzt", 0, "Vor 5 Minuten", -(5 * time.Minute)},
+		{"es war vor zwei Tagen", 7, "vor zwei Tagen", -(2 * 24 * time.Hour)},
+		{"vor drei Wochen", 0, "vor drei Wochen", -(21 * 24 * time.Hour)},
+		{"vor einem Monat", 0, "vor einem Monat", -(31 * 24 * time.Hour)},
+		{"vor einem Jahr", 0, "vor einem Jahr", -(365 * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(de.PastTime(rules.Skip))
+
+	ApplyFixtures(t, "de.PastTime", w, fixt)
+
+	fixtnil := []Fixture{
+		{"Viertel vor drei", 0, "", 0},
+		{"vor halb drei", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "de.PastTime nil", w, fixtnil)
+}
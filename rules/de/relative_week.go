@@ -0,0 +1,51 @@
+package de
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+)
+
+/*
+	"nächste Woche", "kommende Woche" -> 9:00 on the next Monday
+	"letzte Woche" -> -7 days
+	"übernächste Woche" -> 9:00 on the Monday in two weeks
+	"Freitag nächster Woche" -> Friday of the next week
+	"nächsten Monat" -> the first day of the next month
+	"letzten Monat", "vorigen Monat" -> the previous month
+	"dieses Jahr" -> the current year
+*/
+
+func RelativeWeek(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"((?:über)?nächste|kommende|letzte|vergangene|vorige|diese)[nmrs]?" +
+			"\\s+(woche|monat|jahr)" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			shift := 0
+			switch strings.ToLower(m.Captures[0]) {
+			case "übernächste":
+				shift = 2
+			case "nächste", "kommende":
+				shift = 1
+			case "letzte", "vergangene", "vorige":
+				shift = -1
+			}
+
+			period := common.Week
+			switch strings.ToLower(m.Captures[1]) {
+			case "monat":
+				period = common.Month
+			case "jahr":
+				period = common.Year
+			}
+
+			return common.ApplyPeriod(c, ref, period, shift), nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,28 @@
+package de_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/de"
+)
+
+func TestRelativeWeek(t *testing.T) {
+	fixt := []Fixture{
+		{"nächste Woche", 0, "nächste Woche", (5*24 + 9) * time.Hour},
+		{"übernächste Woche", 0, "übernächste Woche", (12*24 + 9) * time.Hour},
+		{"das war letzte Woche", 8, "letzte Woche", -(7 * 24 * time.Hour)},
+		{"diese Woche", 0, "diese Woche", 0},
+		{"nächsten Monat", 0, "nächsten Monat", (26*24 + 9) * time.Hour},
+		{"vorigen Monat", 0, "vorigen Monat", -(31 * 24 * time.Hour)},
+		{"nächstes Jahr", 0, "nächstes Jahr", 366 * 24 * time.Hour},
+		{"dieses Jahr", 0, "dieses Jahr", 0},
+	}
+
+	w := when.New(nil)
+	w.Add(de.RelativeWeek(rules.Override))
+
+	ApplyFixtures(t, "de.RelativeWeek", w, fixt)
+}
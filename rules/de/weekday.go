@@ -0,0 +1,82 @@
+package de
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"Montag", "am Montag" -> the upcoming Monday
+	"nächsten Dienstag" -> the upcoming Tuesday
+	"übernächsten Dienstag" -> a week after the upcoming Tuesday
+	"letzten Freitag" -> the past Friday
+	"diesen Freitag" -> Friday of the current week
+	"nächste Woche Montag", "Freitag nächster Woche" -> in the next week
+	"Montagabend" -> 18:00 on the upcoming Monday
+
+	Weeks start on Monday.
+*/
+
+func Weekday(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"(?:(?:am|an|ab|bis|für)\\s+)?" +
+			"(?:((?:(?:über)?nächste|kommende|letzte|vergangene|vorige|diese)[nmrs]?(?:\\s+woche)?)\\s+)?" +
+			"(" + WEEKDAY_OFFSET_PATTERN[3:] + // skip '(?:'
+			"(" + DAY_PART_PATTERN + ")?" +
+			"(?:\\s+((?:in\\s+der\\s+)?(?:(?:über)?nächste|kommende|letzte|vergangene|vorige|diese)[nr]\\s+woche))?" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			dayInt, ok := WEEKDAY_OFFSET[strings.ToLower(m.Captures[1])]
+			if !ok {
+				return false, nil
+			}
+			c.Weekday = pointer.ToInt(dayInt)
+
+			norm := strings.ToLower(m.Captures[0] + " " + m.Captures[3])
+
+			// count from Monday
+			from := (int(ref.Weekday()) + 6) % 7
+			diff := (dayInt+6)%7 - from
+
+			switch {
+			case strings.Contains(norm, "woche"):
+				switch {
+				case strings.Contains(norm, "übernächst"):
+					diff += 14
+				case strings.Contains(norm, "nächst"), strings.Contains(norm, "kommend"):
+					diff += 7
+				case strings.Contains(norm, "letzt"), strings.Contains(norm, "vergangen"), strings.Contains(norm, "vorig"):
+					diff -= 7
+				}
+			case strings.Contains(norm, "letzt"), strings.Contains(norm, "vergangen"), strings.Contains(norm, "vorig"):
+				if diff >= 0 {
+					diff -= 7
+				}
+			case strings.Contains(norm, "diese"):
+				// the day of the current week
+			default:
+				if diff <= 0 {
+					diff += 7
+				}
+				if strings.Contains(norm, "übernächst") {
+					diff += 7
+				}
+			}
+
+			c.Duration = time.Duration(diff) * 24 * time.Hour
+
+			if hour, ok := dayPartHour(m.Captures[2], o); ok {
+				c.Hour = &hour
+				c.Minute = pointer.ToInt(0)
+			}
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,53 @@
+package de_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/de"
+)
+
+func TestWeekday(t *testing.T) {
+	// current is Wednesday
+	fixt := []Fixture{
+		// upcoming
+		{"Montag", 0, "Montag", 5 * 24 * time.Hour},
+		{"am Freitag", 3, "Freitag", 2 * 24 * time.Hour},
+		{"am Mittwoch", 3, "Mittwoch", 7 * 24 * time.Hour},
+		{"Sonnabend", 0, "Sonnabend", 3 * 24 * time.Hour},
+		// next
+		{"nächsten Dienstag", 0, "nächsten Dienstag", 6 * 24 * time.Hour},
+		{"nächste Woche Montag", 0, "nächste Woche Montag", 5 * 24 * time.Hour},
+		{"kommenden Samstag", 0, "kommenden Samstag", 3 * 24 * time.Hour},
+		{"übernächsten Montag", 0, "übernächsten Montag", 12 * 24 * time.Hour},
+		{"Freitag nächster Woche", 0, "Freitag nächster Woche", 9 * 24 * time.Hour},
+		{"Donnerstag in der übernächsten Woche", 0, "Donnerstag in der übernächsten Woche", 15 * 24 * time.Hour},
+		// last
+		{"letzten Freitag", 0, "letzten Freitag", -(5 * 24 * time.Hour)},
+		{"vergangenen Mittwoch", 0, "vergangenen Mittwoch", -(7 * 24 * time.Hour)},
+		{"Dienstag letzter Woche", 0, "Dienstag letzter Woche", -(8 * 24 * time.Hour)},
+		{"vorige Woche Sonntag", 0, "vorige Woche Sonntag", -(3 * 24 * time.Hour)},
+		// this
+		{"diesen Montag", 0, "diesen Montag", -(2 * 24 * time.Hour)},
+		{"diesen Sonntag", 0, "diesen Sonntag", 4 * 24 * time.Hour},
+		{"Samstag dieser Woche", 0, "Samstag dieser Woche", 3 * 24 * time.Hour},
+		// parts of the day
+		{"Montagabend", 0, "Montagabend", (5*24 + 18) * time.Hour},
+		{"am Donnerstagmorgen", 3, "Donnerstagmorgen", (24 + 8) * time.Hour},
+		{"letzten Sonntagnachmittag", 0, "letzten Sonntagnachmittag", (-3*24 + 15) * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(de.Weekday(rules.Override))
+
+	ApplyFixtures(t, "de.Weekday", w, fixt)
+
+	fixtnil := []Fixture{
+		{"montags", 0, "", 0},
+		{"Freitagskonzert", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "de.Weekday nil", w, fixtnil)
+}
@@ -10,18 +10,12 @@ import (
 )
 
 func CasualTime(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile(`(?i)(?:\W|^)((this)?\s*(morning|afternoon|evening|noon))`),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
 
 			lower := strings.ToLower(strings.TrimSpace(m.String()))
 
-			if (c.Hour != nil || c.Minute != nil) && !overwrite {
-				return false, nil
-			}
-
 			switch {
 			case strings.Contains(lower, "afternoon"):
 				if o.Afternoon != 0 {
@@ -55,5 +49,6 @@ func CasualTime(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
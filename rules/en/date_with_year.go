@@ -42,8 +42,6 @@ func parseYear(yearStr string) (int, error) {
 
 // DayMonthYear handles "Day Month Year" patterns like "5th may 2017", "3 jan 2000"
 func DayMonthYear(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
 			"(" + ORDINAL_WORDS_PATTERN[3:] + "\\s+" +
@@ -52,8 +50,6 @@ func DayMonthYear(s rules.Strategy) rules.Rule {
 			"(?:\\W|$)",
 		),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			_ = overwrite
-
 			ordDay := strings.ToLower(strings.TrimSpace(m.Captures[0]))
 			mon := strings.ToLower(strings.TrimSpace(m.Captures[1]))
 			yearStr := strings.TrimSpace(m.Captures[2])
@@ -73,19 +69,22 @@ func DayMonthYear(s rules.Strategy) rules.Rule {
 				return false, nil
 			}
 
+			if o != nil && o.Strict && day > rules.DaysIn(year, month) {
+				return o.Invalid("day %d of %d-%02d", day, year, month)
+			}
+
 			c.Year = &year
 			c.Month = &month
 			c.Day = &day
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
 
 // DayNumMonthYear handles "Day Month Year" patterns with numeric day like "3 jan 2000", "17 april 85"
 func DayNumMonthYear(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
 			"([0-9]{1,2})\\s+" +
@@ -94,16 +93,17 @@ func DayNumMonthYear(s rules.Strategy) rules.Rule {
 			"(?:\\W|$)",
 		),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			_ = overwrite
-
 			dayStr := strings.TrimSpace(m.Captures[0])
 			mon := strings.ToLower(strings.TrimSpace(m.Captures[1]))
 			yearStr := strings.TrimSpace(m.Captures[2])
 
 			day, err := strconv.Atoi(dayStr)
-			if err != nil || day < 1 || day > 31 {
+			if err != nil {
 				return false, nil
 			}
+			if day < 1 || day > 31 {
+				return o.Invalid("day %d", day)
+			}
 
 			month, ok := MONTH_OFFSET[mon]
 			if !ok {
@@ -115,19 +115,22 @@ func DayNumMonthYear(s rules.Strategy) rules.Rule {
 				return false, nil
 			}
 
+			if o != nil && o.Strict && day > rules.DaysIn(year, month) {
+				return o.Invalid("day %d of %d-%02d", day, year, month)
+			}
+
 			c.Year = &year
 			c.Month = &month
 			c.Day = &day
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
 
 // MonthDayYear handles "Month Day Year" patterns like "jan 3 2010", "february 14, 2004"
 func MonthDayYear(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
 			"(" + MONTH_OFFSET_PATTERN[3:] + "\\s+" +
@@ -136,8 +139,6 @@ func MonthDayYear(s rules.Strategy) rules.Rule {
 			"(?:\\W|$)",
 		),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			_ = overwrite
-
 			mon := strings.ToLower(strings.TrimSpace(m.Captures[0]))
 			ordDay := strings.ToLower(strings.TrimSpace(m.Captures[1]))
 			numDay := strings.TrimSpace(m.Captures[2])
@@ -157,9 +158,12 @@ func MonthDayYear(s rules.Strategy) rules.Rule {
 				day = d
 			} else if numDay != "" {
 				d, err := strconv.Atoi(numDay)
-				if err != nil || d < 1 || d > 31 {
+				if err != nil {
 					return false, nil
 				}
+				if d < 1 || d > 31 {
+					return o.Invalid("day %d", d)
+				}
 				day = d
 			} else {
 				return false, nil
@@ -170,19 +174,22 @@ func MonthDayYear(s rules.Strategy) rules.Rule {
 				return false, nil
 			}
 
+			if o != nil && o.Strict && day > rules.DaysIn(year, month) {
+				return o.Invalid("day %d of %d-%02d", day, year, month)
+			}
+
 			c.Year = &year
 			c.Month = &month
 			c.Day = &day
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
 
 // MonthYear handles "Month Year" patterns like "October 2006", "oct 06"
 func MonthYear(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
 			"(" + MONTH_OFFSET_PATTERN[3:] + "\\s+" +
@@ -190,8 +197,6 @@ func MonthYear(s rules.Strategy) rules.Rule {
 			"(?:\\W|$)",
 		),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			_ = overwrite
-
 			mon := strings.ToLower(strings.TrimSpace(m.Captures[0]))
 			yearStr := strings.TrimSpace(m.Captures[1])
 
@@ -200,6 +205,11 @@ func MonthYear(s rules.Strategy) rules.Rule {
 				return false, nil
 			}
 
+			// a bare number that can be a day is one, like "march 31"
+			if n, err := strconv.Atoi(yearStr); err == nil && n <= 31 && yearStr[0] != '0' {
+				return false, nil
+			}
+
 			year, err := parseYear(yearStr)
 			if err != nil {
 				return false, nil
@@ -213,5 +223,6 @@ func MonthYear(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
@@ -121,4 +121,9 @@ func TestMonthYear(t *testing.T) {
 		require.Equal(t, tc.expectMonth, res.Time.Month(), "month for %s", tc.input)
 		require.Equal(t, tc.expectDay, res.Time.Day(), "day for %s", tc.input)
 	}
+
+	// a number that can be a day is one
+	res, err := w.Parse("march 31", null)
+	require.Nil(t, err)
+	require.Nil(t, res)
 }
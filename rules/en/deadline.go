@@ -2,106 +2,54 @@ package en
 
 import (
 	"regexp"
-	"strconv"
-	"strings"
 	"time"
 
-	"github.com/AlekSi/pointer"
 	"github.com/olebedev/when/rules"
-	"github.com/pkg/errors"
 )
 
-func Deadline(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
+/*
+	"in 5 minutes"
+	"within half an hour"
+	"in 2 hours and 15 minutes"
+	"in 1.5 days"
+	"in 1h30m"
+	"2d 4h", "1h30m", "90s."
+*/
+
+// a number with a short unit written back to back, "2d"
+var compactPairPattern = `[0-9]+(?:\.[0-9]+)?` + DURATION_SHORT_UNIT_PATTERN
+
+// 1. - within or in?
+// 2. - duration after it?
+// 3. - "the" of "the 90s"?
+// 4. - compact duration of two or more parts?
+// 5. - "the" of "the 90s"?
+// 6. - compact duration of one part ending the sentence?
 
+func Deadline(s rules.Strategy) rules.Rule {
 	return &rules.F{
 		RegExp: regexp.MustCompile(
-			"(?i)(?:\\W|^)(within|in)\\s*" +
-				"(" + INTEGER_WORDS_PATTERN + "|[0-9]+|an?(?:\\s*few)?|half(?:\\s*an?)?)\\s*" +
-				"(seconds?|min(?:ute)?s?|hours?|days?|weeks?|months?|years?)\\s*" +
-				"(?:\\W|$)"),
+			"(?i)(?:\\W|^)(?:(within|in)\\s*" +
+				"(" + DURATION_PATTERN + ")\\s*(?:\\W|$)" +
+				// a bare one is written compact, and with one part only
+				// it ends the sentence, not "3d printing"
+				"|(the\\s+)?(" + compactPairPattern + "(?:\\s*" + compactPairPattern + ")+)(?:\\W|$)" +
+				"|(the\\s+)?(" + compactPairPattern + ")\\s*(?:[.,;!?)]|$))"),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-
-			numStr := strings.TrimSpace(m.Captures[1])
-
-			var num int
-			var err error
-
-			if n, ok := INTEGER_WORDS[numStr]; ok {
-				num = n
-			} else if numStr == "a" || numStr == "an" {
-				num = 1
-			} else if strings.Contains(numStr, "few") {
-				num = 3
-			} else if strings.Contains(numStr, "half") {
-				// pass
-			} else {
-				num, err = strconv.Atoi(numStr)
-				if err != nil {
-					return false, errors.Wrapf(err, "convert '%s' to int", numStr)
-				}
+			// a decade, "the 90s"
+			if m.Captures[2] != "" || m.Captures[4] != "" {
+				return false, nil
 			}
 
-			exponent := strings.TrimSpace(m.Captures[2])
-
-			if !strings.Contains(numStr, "half") {
-				switch {
-				case strings.Contains(exponent, "second"):
-					if c.Duration == 0 || overwrite {
-						c.Duration = time.Duration(num) * time.Second
-					}
-				case strings.Contains(exponent, "min"):
-					if c.Duration == 0 || overwrite {
-						c.Duration = time.Duration(num) * time.Minute
-					}
-				case strings.Contains(exponent, "hour"):
-					if c.Duration == 0 || overwrite {
-						c.Duration = time.Duration(num) * time.Hour
-					}
-				case strings.Contains(exponent, "day"):
-					if c.Duration == 0 || overwrite {
-						c.Duration = time.Duration(num) * 24 * time.Hour
-					}
-				case strings.Contains(exponent, "week"):
-					if c.Duration == 0 || overwrite {
-						c.Duration = time.Duration(num) * 7 * 24 * time.Hour
-					}
-				case strings.Contains(exponent, "month"):
-					if c.Month == nil || overwrite {
-						c.Month = pointer.ToInt((int(ref.Month()) + num) % 12)
-					}
-				case strings.Contains(exponent, "year"):
-					if c.Year == nil || overwrite {
-						c.Year = pointer.ToInt(ref.Year() + num)
-					}
-				}
-			} else {
-				switch {
-				case strings.Contains(exponent, "hour"):
-					if c.Duration == 0 || overwrite {
-						c.Duration = 30 * time.Minute
-					}
-				case strings.Contains(exponent, "day"):
-					if c.Duration == 0 || overwrite {
-						c.Duration = 12 * time.Hour
-					}
-				case strings.Contains(exponent, "week"):
-					if c.Duration == 0 || overwrite {
-						c.Duration = 7 * 12 * time.Hour
-					}
-				case strings.Contains(exponent, "month"):
-					if c.Duration == 0 || overwrite {
-						// 2 weeks
-						c.Duration = 14 * 24 * time.Hour
-					}
-				case strings.Contains(exponent, "year"):
-					if c.Month == nil || overwrite {
-						c.Month = pointer.ToInt((int(ref.Month()) + 6) % 12)
-					}
-				}
+			offset, err := parseDuration(m.Captures[1] + m.Captures[3] + m.Captures[5])
+			if err != nil {
+				return false, err
 			}
 
+			offset.apply(c, ref)
+
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
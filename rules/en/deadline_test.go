@@ -24,10 +24,42 @@ func TestDeadline(t *testing.T) {
 		{"within a few months", 0, "within a few months", 91 * 24 * time.Hour},
 		{"within one year", 0, "within one year", 366 * 24 * time.Hour},
 		{"in a week", 0, "in a week", 7 * 24 * time.Hour},
+		{"in twenty minutes", 0, "in twenty minutes", 20 * time.Minute},
+		{"in forty-five minutes", 0, "in forty-five minutes", 45 * time.Minute},
+		{"in a hundred days", 0, "in a hundred days", 100 * 24 * time.Hour},
+		{"in one and a half hours", 0, "in one and a half hours", 90 * time.Minute},
+		{"in 2 and a half days", 0, "in 2 and a half days", 60 * time.Hour},
+		{"in two dozen days", 0, "in two dozen days", 24 * 24 * time.Hour},
+		{"in a couple of hours", 0, "in a couple of hours", 2 * time.Hour},
+		{"within 11 months", 0, "within 11 months", 335 * 24 * time.Hour},
+		{"in 1h30m", 0, "in 1h30m", 90 * time.Minute},
+		{"in 2 hours and 15 minutes", 0, "in 2 hours and 15 minutes", 2*time.Hour + 15*time.Minute},
+		{"in an hour and a half", 0, "in an hour and a half", 90 * time.Minute},
+		{"in a week, 2 days and 3 hours", 0, "in a week, 2 days and 3 hours", 9*24*time.Hour + 3*time.Hour},
+		{"in 1.5 days", 0, "in 1.5 days", 36 * time.Hour},
+		{"in 2d 4h", 0, "in 2d 4h", 52 * time.Hour},
+		{"in 90s", 0, "in 90s", 90 * time.Second},
+		{"in 300ms", 0, "in 300ms", 300 * time.Millisecond},
+		{"in 1 month and 2 days", 0, "in 1 month and 2 days", 33 * 24 * time.Hour},
+		{"2d 4h", 0, "2d 4h", 52 * time.Hour},
+		{"1h30m", 0, "1h30m", 90 * time.Minute},
+		{"done 2d 4h from now", 5, "2d 4h", 52 * time.Hour},
+		{"90s", 0, "90s", 90 * time.Second},
+		{"the timer is 90s.", 13, "90s", 90 * time.Second},
 	}
 
 	w := when.New(nil)
 	w.Add(en.Deadline(rules.Skip))
 
 	ApplyFixtures(t, "en.Deadline", w, fixt)
+
+	fixtnil := []Fixture{
+		{"in ad campaigns", 0, "", 0},
+		{"in a while", 0, "", 0},
+		{"3d printing", 0, "", 0},
+		{"back in the 90s", 0, "", 0},
+		{"the 90s", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "en.Deadline nil", w, fixtnil)
 }
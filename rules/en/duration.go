@@ -0,0 +1,149 @@
+package en
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/pkg/errors"
+)
+
+/*
+	Durations:
+	- "5 minutes"
+	- "2 hours and 15 minutes"
+	- "a week, 2 days and 3 hours"
+	- "an hour and a half"
+	- "1.5 days"
+	- "1h30m", "2d 4h", "90s", "1mo"
+*/
+
+var DURATION_UNIT_PATTERN = `(?:seconds?|secs?|min(?:ute)?s?|hours?|hrs?|days?|weeks?|wks?|months?|years?|yrs?)`
+
+// short units only follow digits, so "in ad" is not "in a day"
+var DURATION_SHORT_UNIT_PATTERN = `(?:ns|us|µs|ms|mo|s|m|h|d|w|y)`
+
+var durationPairPattern = `(?:` + AMOUNT_PATTERN + `\s*` + DURATION_UNIT_PATTERN +
+	`|[0-9]+(?:\.[0-9]+)?\s*` + DURATION_SHORT_UNIT_PATTERN + `)(?:\s+and\s+an?\s+half)?`
+
+// DURATION_PATTERN matches a sequence of amounts and units joined by commas
+// and "and", or written back to back as in "1h30m"
+var DURATION_PATTERN = `(?:` + durationPairPattern +
+	`(?:\s*,?\s*(?:and\s+)?` + durationPairPattern + `)*)`
+
+var durationPairRegExp = regexp.MustCompile(`(?i)^\s*,?\s*(?:and\s+)?` +
+	`(?:(` + AMOUNT_PATTERN + `)\s*(` + DURATION_UNIT_PATTERN + `)` +
+	`|([0-9]+(?:\.[0-9]+)?)\s*(` + DURATION_SHORT_UNIT_PATTERN + `))` +
+	`(\s+and\s+an?\s+half)?`)
+
+// relativeOffset is an amount of time split into calendar months and
+// a fixed duration
+type relativeOffset struct {
+	months   int
+	duration time.Duration
+}
+
+// parseDuration sums up the amounts of a duration matched by DURATION_PATTERN
+func parseDuration(s string) (relativeOffset, error) {
+	var r relativeOffset
+
+	for rest := s; strings.TrimSpace(rest) != ""; {
+		m := durationPairRegExp.FindStringSubmatch(rest)
+		if m == nil {
+			return r, errors.Errorf("parse duration '%s'", s)
+		}
+		rest = rest[len(m[0]):]
+
+		amountStr, unit := m[1], m[2]
+		if m[3] != "" {
+			amountStr, unit = m[3], m[4]
+		}
+
+		amount, err := parseNumber(amountStr)
+		if err != nil {
+			return r, err
+		}
+		if m[5] != "" {
+			amount += 0.5
+		}
+
+		if !r.add(amount, unit) {
+			return r, errors.Errorf("unknown unit '%s' in duration '%s'", unit, s)
+		}
+	}
+
+	return r, nil
+}
+
+// add adds the amount of the unit to the offset. Fractions of a month fall
+// back to four-week durations, fractions of a year are rounded to months.
+func (r *relativeOffset) add(amount float64, unit string) bool {
+	unit = strings.ToLower(unit)
+
+	var duration time.Duration
+	switch {
+	case unit == "ns":
+		duration = time.Nanosecond
+	case unit == "us" || unit == "µs":
+		duration = time.Microsecond
+	case unit == "ms":
+		duration = time.Millisecond
+	case strings.HasPrefix(unit, "mo"):
+		months, fraction := math.Modf(amount)
+		r.months += int(months)
+		r.duration += time.Duration(fraction * float64(28*24*time.Hour))
+		return true
+	case strings.HasPrefix(unit, "y"):
+		r.months += int(math.Round(amount * 12))
+		return true
+	case strings.HasPrefix(unit, "s"):
+		duration = time.Second
+	case strings.HasPrefix(unit, "m"):
+		duration = time.Minute
+	case strings.HasPrefix(unit, "h"):
+		duration = time.Hour
+	case strings.HasPrefix(unit, "d"):
+		duration = 24 * time.Hour
+	case strings.HasPrefix(unit, "w"):
+		duration = 7 * 24 * time.Hour
+	default:
+		return false
+	}
+
+	r.duration += time.Duration(amount * float64(duration))
+	return true
+}
+
+// neg returns the offset pointing to the opposite direction
+func (r relativeOffset) neg() relativeOffset {
+	return relativeOffset{months: -r.months, duration: -r.duration}
+}
+
+// apply sets the context to the offset relative to ref
+func (r relativeOffset) apply(c *rules.Context, ref time.Time) {
+	if r.months != 0 {
+		shiftMonths(c, ref, r.months)
+	}
+	if r.duration != 0 {
+		c.Duration = r.duration
+	}
+}
+
+// shiftMonths sets the year and month fields to the given number of months
+// from ref
+func shiftMonths(c *rules.Context, ref time.Time, months int) {
+	month := int(ref.Month()) - 1 + months
+	year := ref.Year() + month/12
+	if month %= 12; month < 0 {
+		month += 12
+		year--
+	}
+
+	if year != ref.Year() {
+		c.Year = &year
+	}
+	month++
+	c.Month = &month
+}
@@ -1,199 +1,159 @@
 package en
 
-import "github.com/olebedev/when/rules"
-
-var All = []rules.Rule{
-	// Most specific patterns first - ordinal patterns
-	OrdinalWeekdayInMonth(rules.Override), // "3rd wednesday in november"
-	OrdinalDayInWeek(rules.Override),      // "4th day last week"
-	OrdinalMonthInYear(rules.Override),    // "3rd month next year"
-
-	// Date patterns with year
-	DayMonthYear(rules.Override),    // "5th may 2017"
-	DayNumMonthYear(rules.Override), // "3 jan 2000"
-	MonthDayYear(rules.Override),    // "january 5, 2010"
-	MonthYear(rules.Override),       // "October 2006"
-
-	// Weekday and month-date patterns
-	Weekday(rules.Override),        // "next monday"
-	ThisWeekend(rules.Override),    // "this weekend"
-	ExactMonthDate(rules.Override), // "march 5th"
-
-	// Casual expressions (before time patterns to preserve original clustering behavior)
-	CasualDate(rules.Override),     // "tomorrow", "tonight"
-	CasualTime(rules.Override),     // "morning", "afternoon"
-	LaterToday(rules.Override),     // "later today"
-	AfterLunch(rules.Override),     // "after lunch"
-	AfterWork(rules.Override),      // "after work"
-	BeforeEndOfDay(rules.Override), // "before end of day", "before EOD"
-
-	// Time patterns (specific to general)
-	HourRelativeTo(rules.Override), // "10 to 8", "half past 2"
-	HourInPeriod(rules.Override),   // "6 in the morning"
-	HourOClock(rules.Override),     // "11 o'clock"
-	Hour(rules.Override),           // "5pm"
-	HourMinute(rules.Override),     // "5:30pm", "17:00"
-	MilitaryTime(rules.Override),   // "0800"
-
-	// Relative time
-	RelativeNow(rules.Override),  // "5 days from now", "1 week hence"
-	RelativeWeek(rules.Override), // "last week", "next month"
-	NextQuarter(rules.Override),  // "next quarter"
-	Deadline(rules.Override),     // "in 5 minutes"
-	PastTime(rules.Override),     // "5 minutes ago"
+import (
+	_ "embed"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/locale"
+	"regexp"
+)
+
+var describe = rules.Describer("en")
+
+//go:embed en.json
+var vocabulary []byte
+
+// DATA holds the words of the language, see Extend
+var DATA = locale.MustLoad(vocabulary)
+
+var All []rules.Rule
+
+// the patterns of the tables, see build
+var (
+	WEEKDAY_OFFSET_PATTERN string
+	MONTH_OFFSET_PATTERN   string
+	INTEGER_WORDS_PATTERN  string
+	ORDINAL_WORDS_PATTERN  string
+)
+
+func init() {
+	build()
+}
+
+// Extend adds the words of ext to the language and rebuilds All, the
+// parsers made before keep the rules they have. Call it before making the
+// parsers, it isn't safe for concurrent use.
+func Extend(ext *locale.Data) {
+	DATA.Extend(ext)
+	build()
+}
+
+// build makes the patterns of the tables and the rules using them
+func build() {
+	WEEKDAY_OFFSET_PATTERN = locale.Pattern(WEEKDAY_OFFSET)
+	MONTH_OFFSET_PATTERN = locale.Pattern(MONTH_OFFSET)
+	INTEGER_WORDS_PATTERN = locale.Pattern(INTEGER_WORDS)
+	ORDINAL_WORDS_PATTERN = locale.Pattern(ORDINAL_WORDS)
+
+	All = []rules.Rule{
+		// Most specific patterns first - ordinal patterns
+		describe("ordinal_weekday_in_month", rules.Date, 90, OrdinalWeekdayInMonth(rules.Override)), // "3rd wednesday in november", "last friday of march"
+		describe("ordinal_day_in_week", rules.Date, 90, OrdinalDayInWeek(rules.Override)),           // "4th day last week"
+		describe("ordinal_month_in_year", rules.Range, 90, OrdinalMonthInYear(rules.Override)),      // "3rd month next year"
+
+		// Date patterns with year
+		describe("day_month_year", rules.Date, 80, DayMonthYear(rules.Override)),        // "5th may 2017"
+		describe("day_num_month_year", rules.Date, 80, DayNumMonthYear(rules.Override)), // "3 jan 2000"
+		describe("month_day_year", rules.Date, 80, MonthDayYear(rules.Override)),        // "january 5, 2010"
+		describe("month_year", rules.Range, 80, MonthYear(rules.Override)),              // "October 2006"
+
+		// Weekday and month-date patterns
+		describe("weekday", rules.Date, 70, rules.Guard(Weekday(rules.Override), WEEKDAY_GUARDS...)),               // "next monday"
+		describe("this_weekend", rules.Range, 70, ThisWeekend(rules.Override)),                                     // "this weekend"
+		describe("exact_month_date", rules.Date, 70, rules.Guard(ExactMonthDate(rules.Override), MONTH_GUARDS...)), // "march 5th"
+		describe("season", rules.Range, 70, Season(rules.Override)),                                                // "next summer", "winter 2026"
+
+		// Casual expressions (before time patterns to preserve original clustering behavior)
+		describe("casual_date", rules.Date, 60, CasualDate(rules.Override)),           // "tomorrow", "tonight"
+		describe("casual_time", rules.Time, 60, CasualTime(rules.Override)),           // "morning", "afternoon"
+		describe("midnight", rules.Time, 60, Midnight(rules.Override)),                // "midnight", "noon"
+		describe("later_today", rules.Time, 60, LaterToday(rules.Override)),           // "later today"
+		describe("after_lunch", rules.Time, 60, AfterLunch(rules.Override)),           // "after lunch"
+		describe("after_work", rules.Time, 60, AfterWork(rules.Override)),             // "after work"
+		describe("before_end_of_day", rules.Time, 60, BeforeEndOfDay(rules.Override)), // "before end of day", "before EOD"
+
+		// Time patterns (specific to general)
+		describe("hour_relative_to", rules.Time, 50, HourRelativeTo(rules.Override)), // "10 to 8", "half past 2"
+		describe("hour_in_period", rules.Time, 50, HourInPeriod(rules.Override)),     // "6 in the morning"
+		describe("hour_oclock", rules.Time, 50, HourOClock(rules.Override)),          // "11 o'clock"
+		describe("hour", rules.Time, 50, Hour(rules.Override)),                       // "5pm"
+		describe("hour_minute", rules.Time, 50, HourMinute(rules.Override)),          // "5:30pm", "17:00"
+		describe("military_time", rules.Time, 50, MilitaryTime(rules.Override)),      // "0800"
+
+		// Relative time
+		describe("relative_now", rules.Duration, 40, RelativeNow(rules.Override)), // "5 days from now", "1 week hence"
+		describe("relative_week", rules.Range, 40, RelativeWeek(rules.Override)),  // "last week", "next month"
+		describe("next_quarter", rules.Range, 40, NextQuarter(rules.Override)),    // "next quarter"
+		describe("deadline", rules.Duration, 40, Deadline(rules.Override)),        // "in 5 minutes"
+		describe("past_time", rules.Duration, 40, PastTime(rules.Override)),       // "5 minutes ago"
+
+		// Ordinals relative to the current month (after RelativeWeek to refine "next month")
+		describe("ordinal_weekday_of_month", rules.Date, 30, OrdinalWeekdayOfMonth(rules.Override)), // "the last friday of the month"
+		describe("ordinal_day_of_month", rules.Date, 30, OrdinalDayOfMonth(rules.Override)),         // "the 15th", "the 1st of next month"
+	}
 }
 
-var WEEKDAY_OFFSET = map[string]int{
-	"sunday":    0,
-	"sun":       0,
-	"monday":    1,
-	"mon":       1,
-	"tuesday":   2,
-	"tue":       2,
-	"wednesday": 3,
-	"wed":       3,
-	"thursday":  4,
-	"thur":      4,
-	"thu":       4,
-	"friday":    5,
-	"fri":       5,
-	"saturday":  6,
-	"sat":       6,
+// CONNECTORS holds the words joining the parts of a time, like "at" of
+// "tomorrow, after the meeting, at 3pm", see rules.ClusterByTokens
+var CONNECTORS = []string{"at", "on", "in", "by", "of", "the", "for", "from", "around", "about"}
+
+// MONTH_GUARDS keep the months from matching the verbs, like "may" of "may
+// I ask" or "march" of "we march", unless a day or a year comes with them,
+// like "this may 3rd"
+var MONTH_GUARDS = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)\b(?:i|we|you|he|she|it|they|that|this|which|who|there)\s+(?:may|march)\b`),
+	regexp.MustCompile(`(?i)(?:^|[.!?]\s*)may\s+(?:i|we|you|he|she|it|they)\b`),
+	regexp.MustCompile(`(?i)\bmay\s+(?:not|be|have|also|well|still|never)\b`),
 }
 
-var WEEKDAY_OFFSET_PATTERN = "(?:sunday|sun|monday|mon|tuesday|tue|wednesday|wed|thursday|thur|thu|friday|fri|saturday|sat)"
-
-var MONTH_OFFSET = map[string]int{
-	"january":   1,
-	"jan":       1,
-	"jan.":      1,
-	"february":  2,
-	"feb":       2,
-	"feb.":      2,
-	"march":     3,
-	"mar":       3,
-	"mar.":      3,
-	"april":     4,
-	"apr":       4,
-	"apr.":      4,
-	"may":       5,
-	"june":      6,
-	"jun":       6,
-	"jun.":      6,
-	"july":      7,
-	"jul":       7,
-	"jul.":      7,
-	"august":    8,
-	"aug":       8,
-	"aug.":      8,
-	"september": 9,
-	"sep":       9,
-	"sep.":      9,
-	"sept":      9,
-	"sept.":     9,
-	"october":   10,
-	"oct":       10,
-	"oct.":      10,
-	"november":  11,
-	"nov":       11,
-	"nov.":      11,
-	"december":  12,
-	"dec":       12,
-	"dec.":      12,
+// WEEKDAY_GUARDS keep the short weekdays from matching the other words, like
+// "sat" of "she sat down" or "sun" of "the sun is up"
+var WEEKDAY_GUARDS = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)\b(?:i|we|you|he|she|it|they|who|and|then)\s+sat\b`),
+	regexp.MustCompile(`(?i)\bsat\s+(?:down|up|on|with|there|back|around|beside|still|quietly)\b`),
+	regexp.MustCompile(`(?i)\bthe\s+sun\b`),
 }
 
-var MONTH_OFFSET_PATTERN = `(?:january|jan\.?|february|feb\.?|march|mar\.?|april|apr\.?|may|june|jun\.?|july|jul\.?|august|aug\.?|september|sept?\.?|october|oct\.?|november|nov\.?|december|dec\.?)`
-
-var INTEGER_WORDS = map[string]int{
-	"one":    1,
-	"two":    2,
-	"three":  3,
-	"four":   4,
-	"five":   5,
-	"six":    6,
-	"seven":  7,
-	"eight":  8,
-	"nine":   9,
-	"ten":    10,
-	"eleven": 11,
-	"twelve": 12,
+// LABELS are the words labeling the numbers of the things, like "room" of
+// "room 1200", see STOPS
+var LABELS = []string{
+	"room", "order", "flight", "ticket", "ext", "extension", "number",
+	"invoice", "version", "page", "suite", "apt", "unit", "bus", "route", "gate",
+	"model", "id", "code", "pin", "chapter", "section", "step", "item", "issue",
 }
 
-var INTEGER_WORDS_PATTERN = `(?:one|two|three|four|five|six|seven|eight|nine|ten|eleven|twelve)`
-
-var ORDINAL_WORDS = map[string]int{
-	"first":          1,
-	"1st":            1,
-	"second":         2,
-	"2nd":            2,
-	"third":          3,
-	"3rd":            3,
-	"fourth":         4,
-	"4th":            4,
-	"fifth":          5,
-	"5th":            5,
-	"sixth":          6,
-	"6th":            6,
-	"seventh":        7,
-	"7th":            7,
-	"eighth":         8,
-	"8th":            8,
-	"ninth":          9,
-	"9th":            9,
-	"tenth":          10,
-	"10th":           10,
-	"eleventh":       11,
-	"11th":           11,
-	"twelfth":        12,
-	"12th":           12,
-	"thirteenth":     13,
-	"13th":           13,
-	"fourteenth":     14,
-	"14th":           14,
-	"fifteenth":      15,
-	"15th":           15,
-	"sixteenth":      16,
-	"16th":           16,
-	"seventeenth":    17,
-	"17th":           17,
-	"eighteenth":     18,
-	"18th":           18,
-	"nineteenth":     19,
-	"19th":           19,
-	"twentieth":      20,
-	"20th":           20,
-	"twenty first":   21,
-	"twenty-first":   21,
-	"21st":           21,
-	"twenty second":  22,
-	"twenty-second":  22,
-	"22nd":           22,
-	"twenty third":   23,
-	"twenty-third":   23,
-	"23rd":           23,
-	"twenty fourth":  24,
-	"twenty-fourth":  24,
-	"24th":           24,
-	"twenty fifth":   25,
-	"twenty-fifth":   25,
-	"25th":           25,
-	"twenty sixth":   26,
-	"twenty-sixth":   26,
-	"26th":           26,
-	"twenty seventh": 27,
-	"twenty-seventh": 27,
-	"27th":           27,
-	"twenty eighth":  28,
-	"twenty-eighth":  28,
-	"28th":           28,
-	"twenty ninth":   29,
-	"twenty-ninth":   29,
-	"29th":           29,
-	"thirtieth":      30,
-	"30th":           30,
-	"thirty first":   31,
-	"thirty-first":   31,
-	"31st":           31,
+// STOPS keep the numbers of the things, the fractions and the scores from
+// being read as a time or a date, like "room 1200", "1/2 cup" or "won
+// 10-15", see Parser.Stop of the when package
+var STOPS = []*regexp.Regexp{
+	rules.Labeled(LABELS...),
+	// mixed numbers, "2 1/2"
+	regexp.MustCompile(`\b\d+\s+\d/\d+\b`),
+	// fractions of something, "1/2 cup", "3/4 of"
+	regexp.MustCompile(`(?i)\b\d{1,2}/\d{1,2}\s+(?:of|a|an|cups?|teaspoons?|tsp|tablespoons?|tbsp|` +
+		`inch(?:es)?|miles?|pounds?|lbs?|oz|kg|lit(?:er|re)s?|hours?|days?|off)\b`),
+	// scores, "won 10-15"
+	regexp.MustCompile(`(?i)\b(?:score|scored|won|lost|beat|tied|drew)\b[^.!?;\d]{0,20}(\d{1,3}\s*-\s*\d{1,3})\b`),
+}
+
+// the tables of the language, from en.json
+var (
+	WEEKDAY_OFFSET = DATA.Weekdays
+	MONTH_OFFSET   = DATA.Months
+	INTEGER_WORDS  = DATA.Numerals
+	ORDINAL_WORDS  = DATA.Ordinals
+)
+
+// LAST_ORDINAL_WORDS maps ordinals counted from the end of a period, e.g.
+// "second to last friday of the month"
+var LAST_ORDINAL_WORDS = map[string]int{
+	"last":            1,
+	"second to last":  2,
+	"second-to-last":  2,
+	"penultimate":     2,
+	"third to last":   3,
+	"third-to-last":   3,
+	"antepenultimate": 3,
 }
 
-var ORDINAL_WORDS_PATTERN = `(?:1st|first|2nd|second|3rd|third|4th|fourth|5th|fifth|6th|sixth|7th|seventh|8th|eighth|9th|ninth|10th|tenth|11th|eleventh|12th|twelfth|13th|thirteenth|14th|fourteenth|15th|fifteenth|16th|sixteenth|17th|seventeenth|18th|eighteenth|19th|nineteenth|20th|twentieth|21st|twenty[ -]first|22nd|twenty[ -]second|23rd|twenty[ -]third|24th|twenty[ -]fourth|25th|twenty[ -]fifth|26th|twenty[ -]sixth|27th|twenty[ -]seventh|28th|twenty[ -]eighth|29th|twenty[ -]ninth|30th|thirtieth|31st|thirty[ -]first)`
+var LAST_ORDINAL_WORDS_PATTERN = locale.Pattern(LAST_ORDINAL_WORDS)
@@ -35,8 +35,6 @@ import (
 // 5. - ordinal day?
 
 func ExactMonthDate(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)" +
 			"(?:\\W|^)" +
@@ -47,8 +45,6 @@ func ExactMonthDate(s rules.Strategy) rules.Rule {
 		),
 
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			_ = overwrite
-
 			ord1 := strings.ToLower(strings.TrimSpace(m.Captures[0]))
 			num1 := strings.ToLower(strings.TrimSpace(m.Captures[1]))
 			mon := strings.ToLower(strings.TrimSpace(m.Captures[2]))
@@ -102,7 +98,13 @@ func ExactMonthDate(s rules.Strategy) rules.Rule {
 				c.Day = &num
 			}
 
+			// the year may come later, a leap one has all the days
+			if o != nil && o.Strict && c.Day != nil && *c.Day > rules.DaysIn(2000, monInt) {
+				return o.Invalid("day %d of %s", *c.Day, time.Month(monInt))
+			}
+
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
@@ -18,33 +18,56 @@ import (
 	"5A."
 	"5P."
 	"11 P.M."
+	"at 7" -> with rules.MeridiemInfer only
 	https://play.golang.org/p/2Gh35Sl3KP
 */
 
+// 1. - hour?
+// 2. - am or pm?
+// 3. - hour after "at"?
+
 func Hour(s rules.Strategy) rules.Rule {
 
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
-			"(\\d{1,2})" +
+			"(?:(\\d{1,2})" +
 			"(?:\\s*(A\\.|P\\.|A\\.M\\.|P\\.M\\.|AM?|PM?))" +
+			"|at\\s+(\\d{1,2})" +
+			// not "at 5 apples"
+			"(?:\\s*[.,;!?)]|\\s+(?:" + AT_HOUR_FOLLOWERS + ")\\b|\\s*$))" +
 			"(?:\\W|$)"),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if c.Hour != nil && s != rules.Override {
-				return false, nil
-			}
-
-			hour, err := strconv.Atoi(m.Captures[0])
+			hour, err := strconv.Atoi(m.Captures[0] + m.Captures[2])
 			if err != nil {
 				return false, errors.Wrap(err, "hour rule")
 			}
 
+			zero := 0
+			c.Minute = &zero
+			c.Second = &zero
+
+			if m.Captures[1] == "" {
+				if hour > 23 {
+					return o.Invalid("hour %d", hour)
+				}
+				// a bare "at 7" is no time unless asked to infer it
+				if m.Captures[2] != "" && (o == nil || o.Meridiem != rules.MeridiemInfer) {
+					return false, nil
+				}
+				hour = clockHour(hour, c, o, ref)
+				c.Hour = &hour
+				return true, nil
+			}
+
 			if hour > 12 {
-				return false, nil
+				return o.Invalid("hour %d %s", hour, m.Captures[1])
 			}
 
-			zero := 0
 			switch m.Captures[1][0] {
 			case 65, 97: // am
+				if hour == 12 {
+					hour = 0
+				}
 				c.Hour = &hour
 			case 80, 112: // pm
 				if hour < 12 {
@@ -53,9 +76,41 @@ func Hour(s rules.Strategy) rules.Rule {
 				c.Hour = &hour
 			}
 
-			c.Minute = &zero
-			c.Second = &zero
 			return true, nil
 		},
+		Strategy: s,
+	}
+}
+
+// AT_HOUR_FOLLOWERS are the words an hour said after "at" without am or pm
+// can be followed by, "at 7 tomorrow" is the time but "at 3 places" isn't
+var AT_HOUR_FOLLOWERS = `today|tomorrow|tonight|this|next|on|in|and|or|with|then|sharp`
+
+// pmWords and amWords point to the half of the day of an hour said without
+// am or pm, like "7" of "tonight at 7"
+var (
+	pmWords = regexp.MustCompile(`(?i)\b(?:afternoon|evening|tonight|night)\b`)
+	amWords = regexp.MustCompile(`(?i)\b(?:morning)\b`)
+)
+
+// clockHour reads the hour of a 12-hour clock said without am or pm by
+// Options.Meridiem and marks it ambiguous, the words of the text point to
+// the half of the day
+func clockHour(hour int, c *rules.Context, o *rules.Options, ref time.Time) int {
+	if hour < 1 || hour > 11 {
+		return hour
 	}
+
+	hint := rules.NoMeridiem
+	switch {
+	case pmWords.MatchString(c.Text):
+		hint = rules.PM
+	case amWords.MatchString(c.Text):
+		hint = rules.AM
+	}
+
+	hour, inferred := o.InferHour(hour, hint, ref)
+	c.Inferred = c.Inferred || inferred
+	c.AmbiguousClock(hour)
+	return hour
 }
@@ -3,6 +3,7 @@ package en
 import (
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/olebedev/when/rules"
@@ -31,16 +32,12 @@ import (
 func HourMinute(s rules.Strategy) rules.Rule {
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
-			"((?:[0-1]{0,1}[0-9])|(?:2[0-3]))" +
+			"([0-9]{1,2})" +
 			"(?:\\:|：|\\-)" +
-			"((?:[0-5][0-9]))" +
+			"([0-9]{2})" +
 			"(?:\\s*(A\\.|P\\.|A\\.M\\.|P\\.M\\.|AM?|PM?))?" +
 			"(?:\\W|$)"),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if (c.Hour != nil || c.Minute != nil) && s != rules.Override {
-				return false, nil
-			}
-
 			hour, err := strconv.Atoi(m.Captures[0])
 			if err != nil {
 				return false, errors.Wrap(err, "hour minute rule")
@@ -51,17 +48,26 @@ func HourMinute(s rules.Strategy) rules.Rule {
 				return false, errors.Wrap(err, "hour minute rule")
 			}
 
-			if minutes > 59 {
-				return false, nil
+			// "25:00" is a wrong time, "10-75" no time at all
+			if minutes > 59 || hour > 23 {
+				if strings.Contains(m.Text, "-") {
+					return false, nil
+				}
+				if minutes > 59 {
+					return o.Invalid("minute %d", minutes)
+				}
 			}
 			c.Minute = &minutes
 
 			if m.Captures[2] != "" {
 				if hour > 12 {
-					return false, nil
+					return o.Invalid("hour %d %s", hour, m.Captures[2])
 				}
 				switch m.Captures[2][0] {
 				case 65, 97: // am
+					if hour == 12 {
+						hour = 0
+					}
 					c.Hour = &hour
 				case 80, 112: // pm
 					if hour < 12 {
@@ -71,7 +77,12 @@ func HourMinute(s rules.Strategy) rules.Rule {
 				}
 			} else {
 				if hour > 23 {
-					return false, nil
+					return o.Invalid("hour %d", hour)
+				}
+				// "05:30" is of a 24-hour clock, "7:30" is 07:30 unless
+				// asked to infer the half of the day
+				if m.Captures[0][0] != '0' && o != nil && o.Meridiem == rules.MeridiemInfer {
+					hour = clockHour(hour, c, o, ref)
 				}
 				c.Hour = &hour
 			}
@@ -80,5 +91,6 @@ func HourMinute(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
@@ -1,8 +1,8 @@
 package en
 
 import (
+	"math"
 	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
@@ -12,6 +12,7 @@ import (
 /*
 	"10 to 8" -> 7:50
 	"10 past 2" -> 2:10
+	"twenty-five to eight" -> 7:35
 	"half past 2" -> 2:30
 	"quarter to 8" -> 7:45
 	"quarter past 3" -> 3:15
@@ -30,15 +31,11 @@ var MINUTE_WORDS = map[string]int{
 func HourRelativeTo(s rules.Strategy) rules.Rule {
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
-			"(quarter|half|\\d{1,2})\\s+" +
+			"(quarter|half|\\d{1,2}|" + CARDINAL_WORDS_PATTERN + ")\\s+" +
 			"(to|past)\\s+" +
 			"(" + INTEGER_WORDS_PATTERN + "|\\d{1,2})" +
 			"(?:\\W|$)"),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if (c.Hour != nil || c.Minute != nil) && s != rules.Override {
-				return false, nil
-			}
-
 			minuteStr := strings.ToLower(strings.TrimSpace(m.Captures[0]))
 			direction := strings.ToLower(strings.TrimSpace(m.Captures[1]))
 			hourStr := strings.ToLower(strings.TrimSpace(m.Captures[2]))
@@ -47,23 +44,18 @@ func HourRelativeTo(s rules.Strategy) rules.Rule {
 			if min, ok := MINUTE_WORDS[minuteStr]; ok {
 				minutes = min
 			} else {
-				var err error
-				minutes, err = strconv.Atoi(minuteStr)
-				if err != nil || minutes > 59 {
+				num, err := parseNumber(minuteStr)
+				if err != nil || num > 59 || num != math.Trunc(num) {
 					return false, nil
 				}
+				minutes = int(num)
 			}
 
-			var hour int
-			if h, ok := INTEGER_WORDS[hourStr]; ok {
-				hour = h
-			} else {
-				var err error
-				hour, err = strconv.Atoi(hourStr)
-				if err != nil || hour > 12 {
-					return false, nil
-				}
+			num, err := parseNumber(hourStr)
+			if err != nil || num > 12 {
+				return false, nil
 			}
+			hour := int(num)
 
 			if direction == "to" {
 				// "10 to 8" means 7:50
@@ -75,6 +67,7 @@ func HourRelativeTo(s rules.Strategy) rules.Rule {
 			}
 			// "past" means the minutes are added to the hour as-is
 
+			hour = clockHour(hour, c, o, ref)
 			c.Hour = &hour
 			c.Minute = &minutes
 			zero := 0
@@ -82,6 +75,7 @@ func HourRelativeTo(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
 
@@ -94,23 +88,14 @@ func HourInPeriod(s rules.Strategy) rules.Rule {
 			"(morning|afternoon|evening)" +
 			"(?:\\W|$)"),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if c.Hour != nil && s != rules.Override {
-				return false, nil
-			}
-
 			hourStr := strings.ToLower(strings.TrimSpace(m.Captures[0]))
 			period := strings.ToLower(strings.TrimSpace(m.Captures[1]))
 
-			var hour int
-			if h, ok := INTEGER_WORDS[hourStr]; ok {
-				hour = h
-			} else {
-				var err error
-				hour, err = strconv.Atoi(hourStr)
-				if err != nil || hour > 12 {
-					return false, nil
-				}
+			num, err := parseNumber(hourStr)
+			if err != nil || num > 12 {
+				return false, nil
 			}
+			hour := int(num)
 
 			switch period {
 			case "morning":
@@ -137,6 +122,7 @@ func HourInPeriod(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
 
@@ -148,22 +134,16 @@ func HourOClock(s rules.Strategy) rules.Rule {
 			"(o[''`]?clock)" +
 			"(?:\\W|$)"),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if c.Hour != nil && s != rules.Override {
-				return false, nil
-			}
-
 			hourStr := strings.ToLower(strings.TrimSpace(m.Captures[0]))
 
-			var hour int
-			if h, ok := INTEGER_WORDS[hourStr]; ok {
-				hour = h
-			} else {
-				var err error
-				hour, err = strconv.Atoi(hourStr)
-				if err != nil || hour > 23 {
-					return false, nil
-				}
+			num, err := parseNumber(hourStr)
+			if err != nil {
+				return false, nil
+			}
+			if num > 23 {
+				return o.Invalid("hour %v", num)
 			}
+			hour := clockHour(int(num), c, o, ref)
 
 			c.Hour = &hour
 			zero := 0
@@ -172,5 +152,6 @@ func HourOClock(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
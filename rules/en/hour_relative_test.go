@@ -19,6 +19,8 @@ func TestHourRelativeTo(t *testing.T) {
 		{"meet at 10 to 8", 8, "10 to 8", (7 * time.Hour) + (50 * time.Minute)},
 		{"5 past 11", 0, "5 past 11", (11 * time.Hour) + (5 * time.Minute)},
 		{"20 to 4", 0, "20 to 4", (3 * time.Hour) + (40 * time.Minute)},
+		{"twenty past 2", 0, "twenty past 2", (2 * time.Hour) + (20 * time.Minute)},
+		{"twenty-five to eight", 0, "twenty-five to eight", (7 * time.Hour) + (35 * time.Minute)},
 	}
 
 	w := when.New(nil)
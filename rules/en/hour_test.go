@@ -7,6 +7,7 @@ import (
 	"github.com/olebedev/when"
 	"github.com/olebedev/when/rules"
 	"github.com/olebedev/when/rules/en"
+	"github.com/stretchr/testify/require"
 )
 
 func TestHour(t *testing.T) {
@@ -27,4 +28,71 @@ func TestHour(t *testing.T) {
 	w.Add(en.Hour(rules.Override))
 
 	ApplyFixtures(t, "en.Hour", w, fixt)
+
+	fixtnil := []Fixture{
+		{"at 5 apples", 0, "", 0},
+		{"at 7.30", 0, "", 0},
+		{"at 25", 0, "", 0},
+		// no am or pm, unless asked to infer it
+		{"call at 7", 0, "", 0},
+		{"at 7 tomorrow", 0, "", 0},
+		{"meet at 17.", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "en.Hour nil", w, fixtnil)
+}
+
+func TestHourMeridiem(t *testing.T) {
+	morning := null.Add(10 * time.Hour)
+	fixt := []struct {
+		text     string
+		ref      time.Time
+		hour     int
+		inferred bool
+	}{
+		// within the working hours
+		{"lunch at 1", null, 13, true},
+		{"meeting at 3", null, 15, true},
+		{"at 9", null, 9, true},
+		// the half of the day to come
+		{"call at 7", null, 7, true},
+		{"call at 7", morning, 19, true},
+		// the words around it
+		{"tonight at 7", null, 19, true},
+		{"this evening at 3:30", null, 15, true},
+		{"tomorrow morning at 7", morning, 7, true},
+		// said already
+		{"at 7pm", null, 19, false},
+		{"at 17:00", null, 17, false},
+		{"meet at 17.", null, 17, false},
+		{"at 07:30", morning, 7, false},
+	}
+
+	w := when.New(&rules.Options{
+		Distance:     5,
+		MatchByOrder: true,
+		Meridiem:     rules.MeridiemInfer,
+	})
+	w.Add(en.All...)
+
+	for _, f := range fixt {
+		res, err := w.Parse(f.text, f.ref)
+		require.Nil(t, err, f.text)
+		require.NotNil(t, res, f.text)
+		require.Equal(t, f.hour, res.Time.Hour(), f.text)
+		require.Equal(t, f.inferred, res.Inferred, f.text)
+	}
+
+	// the own working hours
+	w.SetOptions(&rules.Options{
+		Distance:     5,
+		MatchByOrder: true,
+		Meridiem:     rules.MeridiemInfer,
+		WorkStart:    20,
+		WorkEnd:      24,
+	})
+	res, err := w.Parse("shift starts at 9", null)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, 21, res.Time.Hour())
 }
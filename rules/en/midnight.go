@@ -0,0 +1,46 @@
+package en
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"midnight" -> the end of today
+	"at midnight tonight" -> the end of today, regardless of Options.Midnight
+	"midnight friday" -> the end of friday, 00:00 on saturday
+	"noon tomorrow", "midday", "12 noon" -> 12:00
+
+	A midnight belongs to the end of its day unless Options.Midnight is set
+	to rules.MidnightStartOfDay. "12am" is always the start of the day and
+	"12pm" is noon, see Hour and HourMinute.
+*/
+
+// Midnight handles midnight and noon
+func Midnight(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile(`(?i)(?:\W|^)((?:12\s*)?(midnight|noon|midday))(?:\W|$)`),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			if strings.ToLower(m.Captures[1]) == "midnight" {
+				c.Hour = pointer.ToInt(o.MidnightHour())
+				// the midnight of tonight is always at its end
+				if strings.Contains(strings.ToLower(c.Text), "tonight") {
+					c.Hour = pointer.ToInt(24)
+				}
+			} else if o.Noon != 0 {
+				c.Hour = &o.Noon
+			} else {
+				c.Hour = pointer.ToInt(12)
+			}
+			c.Minute = pointer.ToInt(0)
+			c.Second = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
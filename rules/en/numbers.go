@@ -0,0 +1,224 @@
+package en
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/olebedev/when/rules/locale"
+
+	"github.com/pkg/errors"
+)
+
+/*
+	Spelled-out numbers:
+	- "twenty" -> 20
+	- "forty-five" -> 45
+	- "a hundred and twenty" -> 120
+	- "two thousand five hundred" -> 2500
+	- "a couple of" -> 2
+	- "a few" -> 3
+	- "two dozen" -> 24
+	- "one and a half" -> 1.5
+	- "half an" -> 0.5
+	- "1.5" -> 1.5
+*/
+
+var NUMBER_WORDS = map[string]int{
+	"zero":      0,
+	"one":       1,
+	"two":       2,
+	"three":     3,
+	"four":      4,
+	"five":      5,
+	"six":       6,
+	"seven":     7,
+	"eight":     8,
+	"nine":      9,
+	"ten":       10,
+	"eleven":    11,
+	"twelve":    12,
+	"thirteen":  13,
+	"fourteen":  14,
+	"fifteen":   15,
+	"sixteen":   16,
+	"seventeen": 17,
+	"eighteen":  18,
+	"nineteen":  19,
+	"twenty":    20,
+	"thirty":    30,
+	"forty":     40,
+	"fifty":     50,
+	"sixty":     60,
+	"seventy":   70,
+	"eighty":    80,
+	"ninety":    90,
+}
+
+var NUMBER_SCALES = map[string]int{
+	"hundred":  100,
+	"thousand": 1000,
+	"million":  1000000,
+}
+
+// numberWordPattern matches the words of NUMBER_WORDS and NUMBER_SCALES
+var numberWordPattern = locale.ListPattern(numberWords())
+
+// numberWords returns the words of NUMBER_WORDS and NUMBER_SCALES and "dozen"
+func numberWords() []string {
+	words := []string{"dozen"}
+	for _, table := range []map[string]int{NUMBER_WORDS, NUMBER_SCALES} {
+		for w := range table {
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+// NUMBER_WORDS_PATTERN matches a spelled-out cardinal like "forty-five",
+// "a hundred and twenty", "two dozen", "a couple of" or "one and a half"
+var NUMBER_WORDS_PATTERN = `(?:(?:an?\s+)?(?:couple(?:\s+of)?|few|` +
+	numberWordPattern + `(?:(?:\s+and\s+|\s*-\s*|\s+)` + numberWordPattern + `)*)` +
+	`(?:\s+and\s+an?\s+half)?)`
+
+// CARDINAL_WORDS_PATTERN matches a plain spelled-out cardinal below a hundred
+// like "twenty" or "forty-five"
+var CARDINAL_WORDS_PATTERN = `(?:` + numberWordPattern +
+	`(?:(?:\s*-\s*|\s+)` + numberWordPattern + `)?)`
+
+// AMOUNT_PATTERN matches a spelled-out or digit amount as used in relative
+// expressions like "in 5 minutes", "twenty days ago" or "half an hour ago"
+var AMOUNT_PATTERN = `(?:` + NUMBER_WORDS_PATTERN +
+	`|[0-9]+(?:\.[0-9]+)?(?:\s+and\s+an?\s+half)?|an?(?:\s*few)?|half(?:\s*an?)?)`
+
+// parseNumber converts a digit or spelled-out amount matched by AMOUNT_PATTERN
+// to its value
+func parseNumber(s string) (float64, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '-'
+	})
+	if len(words) == 0 {
+		return 0, errors.Errorf("convert '%s' to number", s)
+	}
+
+	var total, current, fraction float64
+	article := false
+	for _, w := range words {
+		if n, ok := NUMBER_WORDS[w]; ok {
+			current += float64(n)
+			article = false
+			continue
+		}
+
+		if n, ok := NUMBER_SCALES[w]; ok {
+			if current == 0 {
+				current = 1
+			}
+			current *= float64(n)
+			if n >= 1000 {
+				total += current
+				current = 0
+			}
+			article = false
+			continue
+		}
+
+		switch w {
+		case "and", "of":
+		case "a", "an":
+			// "a" in "one and a half" or "half an" is not a number on its own
+			article = fraction == 0
+		case "half":
+			fraction = 0.5
+			article = false
+		case "couple":
+			current += 2
+			article = false
+		case "few":
+			current += 3
+			article = false
+		case "dozen":
+			if current == 0 {
+				current = 1
+			}
+			current *= 12
+			article = false
+		default:
+			n, err := strconv.ParseFloat(w, 64)
+			if err != nil {
+				return 0, errors.Wrapf(err, "convert '%s' to number", s)
+			}
+			current += n
+			article = false
+		}
+	}
+
+	if article {
+		current++
+	}
+
+	return total + current + fraction, nil
+}
+
+// parseOrdinalNumber converts an ordinal like "3rd", "twelfth" or
+// "twenty-first" to its value
+func parseOrdinalNumber(s string) (int, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	if n, ok := ORDINAL_WORDS[s]; ok {
+		return n, true
+	}
+
+	for _, suffix := range []string{"st", "nd", "rd", "th"} {
+		if !strings.HasSuffix(s, suffix) {
+			continue
+		}
+		if n, err := strconv.Atoi(strings.TrimSuffix(s, suffix)); err == nil {
+			return n, true
+		}
+	}
+
+	// turn the last word into its cardinal, "twenty-first" -> "twenty-one"
+	cut := strings.LastIndexAny(s, " -") + 1
+	last, ok := ordinalCardinals[s[cut:]]
+	if !ok {
+		if !strings.HasSuffix(s, "ieth") {
+			return 0, false
+		}
+		last = strings.TrimSuffix(s[cut:], "ieth") + "y"
+	}
+
+	n, err := parseNumber(s[:cut] + last)
+	if err != nil || n != math.Trunc(n) {
+		return 0, false
+	}
+
+	return int(n), true
+}
+
+// ordinal words whose cardinal is not the ordinal without its suffix
+var ordinalCardinals = map[string]string{
+	"first":       "one",
+	"second":      "two",
+	"third":       "three",
+	"fourth":      "four",
+	"fifth":       "five",
+	"sixth":       "six",
+	"seventh":     "seven",
+	"eighth":      "eight",
+	"ninth":       "nine",
+	"tenth":       "ten",
+	"eleventh":    "eleven",
+	"twelfth":     "twelve",
+	"thirteenth":  "thirteen",
+	"fourteenth":  "fourteen",
+	"fifteenth":   "fifteen",
+	"sixteenth":   "sixteen",
+	"seventeenth": "seventeen",
+	"eighteenth":  "eighteen",
+	"nineteenth":  "nineteen",
+	"hundredth":   "hundred",
+	"thousandth":  "thousand",
+}
@@ -4,6 +4,7 @@ import (
 	"regexp"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/AlekSi/pointer"
 	"github.com/olebedev/when/rules"
@@ -15,6 +16,13 @@ import (
 	- "3rd thursday this september" - Nth weekday of month
 	- "4th day last week" - Nth day of week
 	- "3rd month next year" - Nth month of year
+	- "the last friday of the month" - Nth weekday of a relative month
+	- "the 2nd tuesday of next month" - Nth weekday of a relative month
+	- "the last business day of the month" - Nth business day of a relative month
+	- "the 15th", "on the 1st of next month" - day of a relative month
+
+	The dates keep the clock of the reference, as "the 3rd of march" does,
+	unless a time is said with them.
 */
 
 // getNthWeekdayOfMonth returns the date of the Nth occurrence of a weekday in a given month/year
@@ -35,28 +43,101 @@ func getNthWeekdayOfMonth(year, month int, weekday time.Weekday, n int) time.Tim
 	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
 }
 
+// getNthLastWeekdayOfMonth returns the date of the Nth occurrence of a weekday counted
+// from the end of a given month/year, so n == 1 is the last one
+func getNthLastWeekdayOfMonth(year, month int, weekday time.Weekday, n int) time.Time {
+	// Start with the last day of the month
+	lastDay := time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC)
+
+	// Find the last occurrence of the weekday
+	daysSinceWeekday := int(lastDay.Weekday()) - int(weekday)
+	if daysSinceWeekday < 0 {
+		daysSinceWeekday += 7
+	}
+
+	return lastDay.AddDate(0, 0, -daysSinceWeekday-(n-1)*7)
+}
+
+// getNthBusinessDayOfMonth returns the date of the Nth Monday-to-Friday day of a given
+// month/year, counting from the end of the month if fromEnd is set. The returned date
+// lies outside the month if it has less than n business days.
+func getNthBusinessDayOfMonth(year, month, n int, fromEnd bool) time.Time {
+	day, step := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC), 1
+	if fromEnd {
+		day, step = time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC), -1
+	}
+
+	for ; day.Month() == time.Month(month); day = day.AddDate(0, 0, step) {
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			continue
+		}
+		n--
+		if n == 0 {
+			break
+		}
+	}
+
+	return day
+}
+
+// parseOrdinal returns the number of an ordinal word and whether it counts
+// from the end of the period ("last", "second to last")
+func parseOrdinal(s string) (n int, fromEnd bool, ok bool) {
+	if n, ok = LAST_ORDINAL_WORDS[s]; ok {
+		return n, true, true
+	}
+	n, ok = parseOrdinalNumber(s)
+	return n, false, ok
+}
+
+// resolveRelativeMonth returns the date picked in the month the qualifier refers to:
+// "this" is the current month, "next" and "last" are the adjacent ones and an empty
+// or "the" qualifier is the current month, rolling forward to the following months
+// while the picked date has already passed or doesn't exist.
+func resolveRelativeMonth(ref time.Time, qualifier string, pick func(year, month int) (time.Time, bool)) (time.Time, bool) {
+	offset := 0
+	switch qualifier {
+	case "next":
+		offset = 1
+	case "last", "past":
+		offset = -1
+	}
+
+	today := time.Date(ref.Year(), ref.Month(), ref.Day(), 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 12; i++ {
+		first := time.Date(ref.Year(), ref.Month()+time.Month(offset+i), 1, 0, 0, 0, 0, time.UTC)
+		date, ok := pick(first.Year(), int(first.Month()))
+
+		switch qualifier {
+		case "this", "next", "last", "past":
+			return date, ok
+		}
+
+		if ok && !date.Before(today) {
+			return date, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
 // OrdinalWeekdayInMonth handles patterns like "3rd wednesday in november"
+// and "last friday of march"
 func OrdinalWeekdayInMonth(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
-			"(" + ORDINAL_WORDS_PATTERN[3:] + "\\s+" +
+			"(" + LAST_ORDINAL_WORDS_PATTERN + "|" + ORDINAL_WORDS_PATTERN + ")\\s+" +
 			"(" + WEEKDAY_OFFSET_PATTERN[3:] + "\\s+" +
 			"(?:in|of|this|next|last)?\\s*" +
 			"(" + MONTH_OFFSET_PATTERN[3:] +
 			"(?:\\W|$)",
 		),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if (c.Day != nil || c.Month != nil) && !overwrite {
-				return false, nil
-			}
-
 			ordStr := strings.ToLower(strings.TrimSpace(m.Captures[0]))
 			weekdayStr := strings.ToLower(strings.TrimSpace(m.Captures[1]))
 			monthStr := strings.ToLower(strings.TrimSpace(m.Captures[2]))
 
-			n, ok := ORDINAL_WORDS[ordStr]
+			n, fromEnd, ok := parseOrdinal(ordStr)
 			if !ok {
 				return false, nil
 			}
@@ -78,6 +159,9 @@ func OrdinalWeekdayInMonth(s rules.Strategy) rules.Rule {
 			}
 
 			targetDate := getNthWeekdayOfMonth(year, month, time.Weekday(weekdayOffset), n)
+			if fromEnd {
+				targetDate = getNthLastWeekdayOfMonth(year, month, time.Weekday(weekdayOffset), n)
+			}
 
 			// Validate the date is in the correct month (n might be too large)
 			if targetDate.Month() != time.Month(month) {
@@ -91,13 +175,12 @@ func OrdinalWeekdayInMonth(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
 
 // OrdinalDayInWeek handles patterns like "4th day last week"
 func OrdinalDayInWeek(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
 			"(" + ORDINAL_WORDS_PATTERN[3:] + "\\s+" +
@@ -106,14 +189,10 @@ func OrdinalDayInWeek(s rules.Strategy) rules.Rule {
 			"(?:\\W|$)",
 		),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if c.Duration != 0 && !overwrite {
-				return false, nil
-			}
-
 			ordStr := strings.ToLower(strings.TrimSpace(m.Captures[0]))
 			direction := strings.ToLower(strings.TrimSpace(m.Captures[2]))
 
-			n, ok := ORDINAL_WORDS[ordStr]
+			n, ok := parseOrdinalNumber(ordStr)
 			if !ok || n < 1 || n > 7 {
 				return false, nil
 			}
@@ -146,13 +225,12 @@ func OrdinalDayInWeek(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
 
 // OrdinalMonthInYear handles patterns like "3rd month next year"
 func OrdinalMonthInYear(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
 			"(" + ORDINAL_WORDS_PATTERN[3:] + "\\s+" +
@@ -161,14 +239,10 @@ func OrdinalMonthInYear(s rules.Strategy) rules.Rule {
 			"(?:\\W|$)",
 		),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if (c.Month != nil || c.Year != nil) && !overwrite {
-				return false, nil
-			}
-
 			ordStr := strings.ToLower(strings.TrimSpace(m.Captures[0]))
 			direction := strings.ToLower(strings.TrimSpace(m.Captures[2]))
 
-			n, ok := ORDINAL_WORDS[ordStr]
+			n, ok := parseOrdinalNumber(ordStr)
 			if !ok || n < 1 || n > 12 {
 				return false, nil
 			}
@@ -191,5 +265,115 @@ func OrdinalMonthInYear(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
+	}
+}
+
+// OrdinalWeekdayOfMonth handles patterns like "the last friday of the month",
+// "the 2nd tuesday of next month" and "the first business day of this month"
+func OrdinalWeekdayOfMonth(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
+			"(?:on\\s+)?(?:the\\s+)?" +
+			"(" + LAST_ORDINAL_WORDS_PATTERN + "|" + ORDINAL_WORDS_PATTERN + ")\\s+" +
+			"((?:business|working|week)\\s*day|" + WEEKDAY_OFFSET_PATTERN + ")\\s+" +
+			"(?:of|in)\\s+(the|this|next|last)\\s+(month)" +
+			"(?:\\W|$)",
+		),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			ordStr := strings.ToLower(strings.TrimSpace(m.Captures[0]))
+			dayStr := strings.ToLower(strings.TrimSpace(m.Captures[1]))
+			qualifier := strings.ToLower(strings.TrimSpace(m.Captures[2]))
+
+			n, fromEnd, ok := parseOrdinal(ordStr)
+			if !ok {
+				return false, nil
+			}
+
+			var pick func(year, month int) (time.Time, bool)
+			if weekdayOffset, ok := WEEKDAY_OFFSET[dayStr]; ok {
+				pick = func(year, month int) (time.Time, bool) {
+					date := getNthWeekdayOfMonth(year, month, time.Weekday(weekdayOffset), n)
+					if fromEnd {
+						date = getNthLastWeekdayOfMonth(year, month, time.Weekday(weekdayOffset), n)
+					}
+					return date, date.Month() == time.Month(month)
+				}
+			} else {
+				pick = func(year, month int) (time.Time, bool) {
+					date := getNthBusinessDayOfMonth(year, month, n, fromEnd)
+					return date, date.Month() == time.Month(month)
+				}
+			}
+
+			targetDate, ok := resolveRelativeMonth(ref, qualifier, pick)
+			if !ok {
+				return false, nil
+			}
+
+			c.Year = pointer.ToInt(targetDate.Year())
+			c.Month = pointer.ToInt(int(targetDate.Month()))
+			c.Day = pointer.ToInt(targetDate.Day())
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
+
+// OrdinalDayOfMonth handles patterns like "the 15th", "on the 1st of next month"
+// and "the last day of the month". Without a month the day resolves to the
+// current month and rolls forward to the next one once it has passed.
+func OrdinalDayOfMonth(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
+			"(?:on\\s+)?the\\s+" +
+			"(" + LAST_ORDINAL_WORDS_PATTERN + "|" + ORDINAL_WORDS_PATTERN + ")" +
+			"(?:\\s+(day|week|month|year))?" +
+			"(?:\\s+(?:of\\s+)?(?:(the|this|next|last|past)\\s+(month|week|year)|(" + MONTH_OFFSET_PATTERN[3:] + "))?" +
+			"(?:\\W|$)",
+		),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			ordStr := strings.ToLower(strings.TrimSpace(m.Captures[0]))
+			noun := strings.ToLower(strings.TrimSpace(m.Captures[1]))
+			qualifier := strings.ToLower(strings.TrimSpace(m.Captures[2]))
+			unit := strings.ToLower(strings.TrimSpace(m.Captures[3]))
+			month := strings.TrimSpace(m.Captures[4])
+
+			// leave "the 3rd of march", "the 2nd week" and "the 4th day
+			// last week" to the month-date and ordinal week/year rules
+			if month != "" || (noun != "" && noun != "day") || (unit != "" && unit != "month") {
+				return false, nil
+			}
+
+			n, fromEnd, ok := parseOrdinal(ordStr)
+			if !ok {
+				return false, nil
+			}
+
+			// a bare "the last" or "the second" is not a date, only
+			// digit ordinals like "the 15th" stand on their own
+			if noun == "" && unit == "" && (fromEnd || !unicode.IsDigit(rune(ordStr[0]))) {
+				return false, nil
+			}
+
+			targetDate, ok := resolveRelativeMonth(ref, qualifier, func(year, month int) (time.Time, bool) {
+				date := time.Date(year, time.Month(month), n, 0, 0, 0, 0, time.UTC)
+				if fromEnd {
+					date = time.Date(year, time.Month(month)+1, 1-n, 0, 0, 0, 0, time.UTC)
+				}
+				return date, date.Month() == time.Month(month)
+			})
+			if !ok {
+				return false, nil
+			}
+
+			c.Year = pointer.ToInt(targetDate.Year())
+			c.Month = pointer.ToInt(int(targetDate.Month()))
+			c.Day = pointer.ToInt(targetDate.Day())
+
+			return true, nil
+		},
+		Strategy: s,
 	}
 }
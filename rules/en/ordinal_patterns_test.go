@@ -27,6 +27,8 @@ func TestOrdinalWeekdayInMonth(t *testing.T) {
 		{"1st monday in march", "1st monday in march", 2016, time.March, 7},
 		{"2nd friday in june", "2nd friday in june", 2016, time.June, 10},
 		{"fourth sunday in december", "fourth sunday in december", 2016, time.December, 25},
+		{"last friday of march", "last friday of march", 2016, time.March, 25},
+		{"second to last sunday in november", "second to last sunday in november", 2016, time.November, 20},
 	}
 
 	for _, tc := range testCases {
@@ -91,3 +93,107 @@ func TestOrdinalMonthInYear(t *testing.T) {
 		require.Equal(t, tc.expectMonth, res.Time.Month(), "month for %s", tc.input)
 	}
 }
+
+func TestOrdinalWeekdayOfMonth(t *testing.T) {
+	w := when.New(nil)
+	w.Add(en.OrdinalWeekdayOfMonth(rules.Override))
+
+	// null is January 6, 2016 (Wednesday)
+	testCases := []struct {
+		input       string
+		matchText   string
+		expectYear  int
+		expectMonth time.Month
+		expectDay   int
+	}{
+		{"the last friday of the month", "last friday of the month", 2016, time.January, 29},
+		{"the second to last monday of next month", "second to last monday of next month", 2016, time.February, 22},
+		{"the first friday of the month", "first friday of the month", 2016, time.February, 5}, // Jan 1 has passed
+		{"the first friday of this month", "first friday of this month", 2016, time.January, 1},
+		{"the 2nd tuesday of last month", "2nd tuesday of last month", 2015, time.December, 8},
+		{"the last business day of the month", "last business day of the month", 2016, time.January, 29},
+		{"on the first working day of next month", "first working day of next month", 2016, time.February, 1},
+	}
+
+	for _, tc := range testCases {
+		res, err := w.Parse(tc.input, null)
+		require.Nil(t, err, "error for %s", tc.input)
+		require.NotNil(t, res, "result for %s", tc.input)
+		require.Equal(t, tc.matchText, res.Text, "text for %s", tc.input)
+		require.Equal(t, tc.expectYear, res.Time.Year(), "year for %s", tc.input)
+		require.Equal(t, tc.expectMonth, res.Time.Month(), "month for %s", tc.input)
+		require.Equal(t, tc.expectDay, res.Time.Day(), "day for %s", tc.input)
+	}
+}
+
+func TestOrdinalDayOfMonth(t *testing.T) {
+	w := when.New(nil)
+	w.Add(en.OrdinalDayOfMonth(rules.Override))
+
+	// null is January 6, 2016
+	fixt := []Fixture{
+		{"the 15th", 4, "15th", 9 * 24 * time.Hour},
+		{"pay rent on the 6th", 16, "6th", 0},
+		{"on the 5th", 7, "5th", 30 * 24 * time.Hour}, // Jan 5 has passed
+		{"the 1st of next month", 4, "1st of next month", 26 * 24 * time.Hour},
+		{"the last day of the month", 4, "last day of the month", 25 * 24 * time.Hour},
+		{"the second to last day of next month", 4, "second to last day of next month", 53 * 24 * time.Hour},
+	}
+
+	ApplyFixtures(t, "en.OrdinalDayOfMonth", w, fixt)
+
+	fixtNil := []Fixture{
+		{"the second opinion", 0, "", 0},
+		{"the last one", 0, "", 0},
+		{"the 31st of next month", 0, "", 0},
+		{"the 3rd of march", 0, "", 0},
+		{"the 2nd week of next month", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "en.OrdinalDayOfMonth nil", w, fixtNil)
+}
+
+func TestOrdinalOfMonthAll(t *testing.T) {
+	w := when.New(nil)
+	w.Add(en.All...)
+
+	fixt := []Fixture{
+		{"the last friday of the month", 4, "last friday of the month", 23 * 24 * time.Hour},
+		{"pay rent on the 1st of next month", 16, "1st of next month", 26 * 24 * time.Hour},
+		{"the 15th at 3pm", 4, "15th at 3pm", (9*24 + 15) * time.Hour},
+		{"the 3rd of march", 4, "3rd of march", 1368 * time.Hour},
+		{"the 4th day last week", 4, "4th day last week", -7 * 24 * time.Hour},
+	}
+
+	ApplyFixtures(t, "en.All ordinal of month", w, fixt)
+}
+
+func TestOrdinalClock(t *testing.T) {
+	w := when.New(nil)
+	w.Add(en.All...)
+
+	// a date said by an ordinal keeps the clock of the reference, as "the
+	// 3rd of march" does, unless a time is said with it
+	ref := null.Add(10 * time.Hour)
+	fixt := []struct {
+		text string
+		want time.Time
+	}{
+		{"the 15th", time.Date(2016, 1, 15, 10, 0, 0, 0, time.UTC)},
+		{"the 1st of next month", time.Date(2016, 2, 1, 10, 0, 0, 0, time.UTC)},
+		{"the last day of the month", time.Date(2016, 1, 31, 10, 0, 0, 0, time.UTC)},
+		{"the last friday of the month", time.Date(2016, 1, 29, 10, 0, 0, 0, time.UTC)},
+		{"the first working day of next month", time.Date(2016, 2, 1, 10, 0, 0, 0, time.UTC)},
+		{"3rd wednesday in november", time.Date(2016, 11, 16, 10, 0, 0, 0, time.UTC)},
+		{"the 3rd of march", time.Date(2016, 3, 3, 10, 0, 0, 0, time.UTC)},
+		{"the 1st of next month at 3pm", time.Date(2016, 2, 1, 15, 0, 0, 0, time.UTC)},
+		{"the last friday of the month at 8am", time.Date(2016, 1, 29, 8, 0, 0, 0, time.UTC)},
+	}
+
+	for _, f := range fixt {
+		res, err := w.Parse(f.text, ref)
+		require.Nil(t, err, f.text)
+		require.NotNil(t, res, f.text)
+		require.Equal(t, f.want, res.Time, f.text)
+	}
+}
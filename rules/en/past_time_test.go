@@ -24,6 +24,12 @@ func TestPastTime(t *testing.T) {
 		{"a few months ago", 0, "a few months ago", -(92 * 24 * time.Hour)},
 		{"one year ago", 0, "one year ago", -(365 * 24 * time.Hour)},
 		{"a week ago", 0, "a week ago", -(7 * 24 * time.Hour)},
+		{"forty-five minutes ago", 0, "forty-five minutes ago", -(45 * time.Minute)},
+		{"twenty one days ago", 0, "twenty one days ago", -(21 * 24 * time.Hour)},
+		{"a couple of weeks ago", 0, "a couple of weeks ago", -(14 * 24 * time.Hour)},
+		{"one and a half hours ago", 0, "one and a half hours ago", -(90 * time.Minute)},
+		{"1h30m ago", 0, "1h30m ago", -(90 * time.Minute)},
+		{"2 days and 3 hours ago", 0, "2 days and 3 hours ago", -(51 * time.Hour)},
 	}
 
 	w := when.New(nil)
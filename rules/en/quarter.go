@@ -9,15 +9,9 @@ import (
 )
 
 func NextQuarter(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile(`(?i)(?:\W|^)(next\s+quarter)(?:\W|$)`),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if (c.Month != nil || c.Day != nil) && !overwrite {
-				return false, nil
-			}
-
 			// Quarters: Q1=Jan(1), Q2=Apr(4), Q3=Jul(7), Q4=Oct(10)
 			currentMonth := int(ref.Month())
 			currentQuarter := (currentMonth - 1) / 3
@@ -36,5 +30,6 @@ func NextQuarter(s rules.Strategy) rules.Rule {
 			c.Minute = pointer.ToInt(0)
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
@@ -19,6 +19,9 @@ func TestRelativeNow(t *testing.T) {
 		{"2 weeks from now", 0, "2 weeks from now", 14 * 24 * time.Hour},
 		{"a week from now", 0, "a week from now", 7 * 24 * time.Hour},
 		{"an hour from now", 0, "an hour from now", time.Hour},
+		{"thirty seconds from now", 0, "thirty seconds from now", 30 * time.Second},
+		{"a hundred and twenty minutes from now", 0, "a hundred and twenty minutes from now", 2 * time.Hour},
+		{"2 hours and 30 minutes from now", 0, "2 hours and 30 minutes from now", 150 * time.Minute},
 	}
 
 	w := when.New(nil)
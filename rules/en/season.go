@@ -0,0 +1,75 @@
+package en
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"this summer" -> the current or upcoming summer
+	"next spring" -> the first spring starting after now
+	"last winter" -> the last winter that has already ended
+	"in the fall" -> the current or upcoming autumn
+	"winter 2026" -> the winter starting in 2026
+
+	Seasons resolve to their start day, the boundaries depend on the
+	Hemisphere and SeasonMapping options.
+*/
+
+var SEASONS = map[string]rules.Season{
+	"spring": rules.Spring,
+	"summer": rules.Summer,
+	"autumn": rules.Autumn,
+	"fall":   rules.Autumn,
+	"winter": rules.Winter,
+}
+
+func Season(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
+			"(?:(in)\\s+)?" +
+			"(?:(this|next|last|past|coming|the)\\s+)?" +
+			"(spring|summer|autumn|fall|winter)" +
+			"(?:\\s+(?:of\\s+)?('?[0-9]{2,4}))?" +
+			"(?:\\W|$)",
+		),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			in := strings.TrimSpace(m.Captures[0])
+			direction := strings.ToLower(strings.TrimSpace(m.Captures[1]))
+			season := SEASONS[strings.ToLower(strings.TrimSpace(m.Captures[2]))]
+			yearStr := strings.TrimSpace(m.Captures[3])
+
+			// a bare "spring" or "fall" is too ambiguous to be a date
+			if in == "" && direction == "" && yearStr == "" {
+				return false, nil
+			}
+
+			var start time.Time
+			switch {
+			case yearStr != "":
+				year, err := parseYear(yearStr)
+				if err != nil {
+					return false, nil
+				}
+				start = o.SeasonStart(season, year)
+			case direction == "next":
+				start = o.SeasonOccurrence(season, 1, ref)
+			case direction == "last" || direction == "past":
+				start = o.SeasonOccurrence(season, -1, ref)
+			default:
+				start = o.SeasonOccurrence(season, 0, ref)
+			}
+
+			c.Year = pointer.ToInt(start.Year())
+			c.Month = pointer.ToInt(int(start.Month()))
+			c.Day = pointer.ToInt(start.Day())
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,71 @@
+package en_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/en"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeason(t *testing.T) {
+	w := when.New(nil)
+	w.Add(en.Season(rules.Override))
+
+	// null is January 6, 2016, in the middle of the northern winter
+	fixt := []Fixture{
+		{"this summer", 0, "this summer", 147 * 24 * time.Hour},
+		{"next spring", 0, "next spring", 55 * 24 * time.Hour},
+		{"let's do it in the fall", 12, "in the fall", 239 * 24 * time.Hour},
+		{"this winter", 0, "this winter", -36 * 24 * time.Hour},
+		{"next winter", 0, "next winter", 330 * 24 * time.Hour},
+		{"last summer", 0, "last summer", -219 * 24 * time.Hour},
+		{"last winter", 0, "last winter", -401 * 24 * time.Hour},
+		{"winter 2026", 0, "winter 2026", 3982 * 24 * time.Hour},
+		{"summer of '17", 0, "summer of '17", 512 * 24 * time.Hour},
+	}
+
+	ApplyFixtures(t, "en.Season", w, fixt)
+
+	fixtNil := []Fixture{
+		{"spring cleaning", 0, "", 0},
+		{"don't fall asleep", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "en.Season nil", w, fixtNil)
+}
+
+func TestSeasonOptions(t *testing.T) {
+	testCases := []struct {
+		options     rules.Options
+		ref         time.Time
+		input       string
+		expectYear  int
+		expectMonth time.Month
+		expectDay   int
+	}{
+		{rules.Options{Hemisphere: rules.Southern}, null, "next summer", 2016, time.December, 1},
+		{rules.Options{Hemisphere: rules.Southern}, null, "this summer", 2015, time.December, 1},
+		{rules.Options{Hemisphere: rules.Southern}, null, "in the fall", 2016, time.March, 1},
+		{rules.Options{}, time.Date(2016, time.March, 10, 0, 0, 0, 0, time.UTC), "this winter", 2016, time.December, 1},
+		{rules.Options{}, time.Date(2016, time.March, 10, 0, 0, 0, 0, time.UTC), "last winter", 2015, time.December, 1},
+		{rules.Options{SeasonMapping: rules.Astronomical}, time.Date(2016, time.March, 10, 0, 0, 0, 0, time.UTC), "this winter", 2015, time.December, 21},
+		{rules.Options{SeasonMapping: rules.Astronomical}, time.Date(2016, time.March, 10, 0, 0, 0, 0, time.UTC), "last winter", 2014, time.December, 21},
+		{rules.Options{SeasonMapping: rules.Astronomical}, time.Date(2016, time.March, 10, 0, 0, 0, 0, time.UTC), "this spring", 2016, time.March, 20},
+	}
+
+	for _, tc := range testCases {
+		options := tc.options
+		w := when.New(&options)
+		w.Add(en.Season(rules.Override))
+
+		res, err := w.Parse(tc.input, tc.ref)
+		require.Nil(t, err, "error for %s", tc.input)
+		require.NotNil(t, res, "result for %s", tc.input)
+		require.Equal(t, tc.expectYear, res.Time.Year(), "year for %s", tc.input)
+		require.Equal(t, tc.expectMonth, res.Time.Month(), "month for %s", tc.input)
+		require.Equal(t, tc.expectDay, res.Time.Day(), "day for %s", tc.input)
+	}
+}
@@ -9,15 +9,9 @@ import (
 )
 
 func ThisWeekend(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile(`(?i)(?:\W|^)(this\s+weekend)(?:\W|$)`),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if c.Duration != 0 && !overwrite {
-				return false, nil
-			}
-
 			// Saturday is weekday 6
 			daysUntilSaturday := (6 - int(ref.Weekday()) + 7) % 7
 			if daysUntilSaturday == 0 && ref.Hour() >= 10 {
@@ -30,5 +24,6 @@ func ThisWeekend(s rules.Strategy) rules.Rule {
 			c.Minute = pointer.ToInt(0)
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
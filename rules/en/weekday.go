@@ -10,8 +10,6 @@ import (
 )
 
 func Weekday(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)" +
 			"(?:\\W|^)" +
@@ -23,8 +21,6 @@ func Weekday(s rules.Strategy) rules.Rule {
 		),
 
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			_ = overwrite
-
 			day := strings.ToLower(strings.TrimSpace(m.Captures[1]))
 			norm := strings.ToLower(strings.TrimSpace(m.Captures[0] + m.Captures[2]))
 			if norm == "" {
@@ -34,10 +30,7 @@ func Weekday(s rules.Strategy) rules.Rule {
 			if !ok {
 				return false, nil
 			}
-
-			if c.Duration != 0 && !overwrite {
-				return false, nil
-			}
+			c.Weekday = pointer.ToInt(dayInt)
 
 			// Switch:
 			switch {
@@ -81,6 +74,32 @@ func Weekday(s rules.Strategy) rules.Rule {
 				}
 			}
 
+		// the day of the week named after it, the weeks start on monday
+		if week := strings.ToLower(m.Captures[2]); week != "" {
+			shift := 0
+			switch week {
+			case "next":
+				shift = 1
+			case "last", "past":
+				shift = -1
+			}
+			from := (int(ref.Weekday()) + 6) % 7
+			to := (dayInt + 6) % 7
+			c.Duration = time.Duration(shift*7+to-from) * 24 * time.Hour
+		}
+
+		// "next friday" could be the one of the week after too, a plain
+		// "friday" is the upcoming one
+		if m.Captures[2] == "" && strings.Contains(strings.ToLower(m.Captures[0]), "next") {
+			c.Ambiguous("upcoming", 0.6, rules.Alternative{
+				Label:      "week after",
+				Confidence: 0.4,
+				Apply: func(c *rules.Context) {
+					c.Duration += 7 * 24 * time.Hour
+				},
+			})
+		}
+
 		// Add default 09:00 time if no time specified
 		if c.Hour == nil && c.Minute == nil {
 			c.Hour = pointer.ToInt(9)
@@ -89,5 +108,6 @@ func Weekday(s rules.Strategy) rules.Rule {
 
 		return true, nil
 	},
+		Strategy: s,
 	}
 }
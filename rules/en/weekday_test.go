@@ -26,6 +26,11 @@ func TestWeekday(t *testing.T) {
 		{"this tuesday", 0, "this tuesday", -(24*time.Hour - 9*time.Hour)},                       // -1d + 9h = -15h
 		{"drop me a line at this wednesday", 18, "this wednesday", 9 * time.Hour},                // same day, 9h offset
 		{"this saturday", 0, "this saturday", (3*24 + 9) * time.Hour},                            // 3d + 9h = 81h
+		// the day of the week named after it, the weeks start on monday
+		{"friday next week", 0, "friday next", (9*24 + 9) * time.Hour},                           // 9d + 9h = 225h
+		{"monday next week", 0, "monday next", (5*24 + 9) * time.Hour},                           // 5d + 9h = 129h
+		{"monday this week", 0, "monday this", -(2*24*time.Hour - 9*time.Hour)},                  // -2d + 9h = -39h
+		{"sunday last week", 0, "sunday last", -(3*24*time.Hour - 9*time.Hour)},                  // -3d + 9h = -63h
 	}
 
 	w := when.New(nil)
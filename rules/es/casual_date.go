@@ -0,0 +1,50 @@
+package es
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"ahora", "hoy" -> today
+	"mañana" -> tomorrow
+	"pasado mañana" -> the day after tomorrow
+	"ayer" -> yesterday
+	"anteayer", "antes de ayer" -> the day before yesterday
+
+	"mañana" is the morning when it follows an article like "la" or
+	"por la", it is left to the casual time there.
+*/
+
+func CasualDate(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"((?:esta|por\\s+la|de\\s+la|en\\s+la|la)\\s+)?" +
+			"(ahora|hoy|pasado\\s+mañana|mañana|anteayer|antier|antes\\s+de\\s+ayer|ayer)" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			day := strings.Join(strings.Fields(strings.ToLower(m.Captures[1])), " ")
+
+			if day == "mañana" && m.Captures[0] != "" {
+				return false, nil
+			}
+
+			switch day {
+			case "pasado mañana":
+				c.Duration = 2 * 24 * time.Hour
+			case "mañana":
+				c.Duration = 24 * time.Hour
+			case "anteayer", "antier", "antes de ayer":
+				c.Duration = -(2 * 24 * time.Hour)
+			case "ayer":
+				c.Duration = -(24 * time.Hour)
+			}
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,83 @@
+package es_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/es"
+)
+
+func TestCasualDate(t *testing.T) {
+	fixt := []Fixture{
+		{"El plazo es ahora", 12, "ahora", 0},
+		{"El plazo es hoy", 12, "hoy", 0},
+		{"El plazo es mañana", 12, "mañana", 24 * time.Hour},
+		{"El plazo es pasado mañana", 12, "pasado mañana", 2 * 24 * time.Hour},
+		{"El plazo fue ayer", 13, "ayer", -(24 * time.Hour)},
+		{"El plazo fue anteayer", 13, "anteayer", -(2 * 24 * time.Hour)},
+		{"El plazo fue antes de ayer", 13, "antes de ayer", -(2 * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(es.CasualDate(rules.Skip))
+
+	ApplyFixtures(t, "es.CasualDate", w, fixt)
+
+	fixtnil := []Fixture{
+		{"por la mañana", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "es.CasualDate nil", w, fixtnil)
+}
+
+func TestCasualTime(t *testing.T) {
+	fixt := []Fixture{
+		{"El plazo fue esta mañana", 13, "esta mañana", 8 * time.Hour},
+		{"El plazo es por la mañana", 12, "por la mañana", 8 * time.Hour},
+		{"El plazo es esta tarde", 12, "esta tarde", 15 * time.Hour},
+		{"El plazo es esta noche", 12, "esta noche", 18 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(es.CasualTime(rules.Skip))
+
+	ApplyFixtures(t, "es.CasualTime", w, fixt)
+
+	fixtnil := []Fixture{
+		{"mañana", 0, "", 0},
+		{"tarde", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "es.CasualTime nil", w, fixtnil)
+}
+
+func TestCasualDateCasualTime(t *testing.T) {
+	fixt := []Fixture{
+		{"El plazo es mañana por la mañana", 12, "mañana por la mañana", (24 + 8) * time.Hour},
+		{"El plazo fue ayer por la noche", 13, "ayer por la noche", -(6 * time.Hour)},
+		{"El plazo es pasado mañana por la tarde", 12, "pasado mañana por la tarde", (2*24 + 15) * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(
+		es.CasualDate(rules.Skip),
+		es.CasualTime(rules.Override),
+	)
+
+	ApplyFixtures(t, "es.CasualDate|es.CasualTime", w, fixt)
+}
+
+func TestMidnight(t *testing.T) {
+	fixt := []Fixture{
+		{"a medianoche", 0, "a medianoche", 24 * time.Hour},
+		{"al mediodía", 0, "al mediodía", 12 * time.Hour},
+		{"hacia el mediodia", 9, "mediodia", 12 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(es.Midnight(rules.Override))
+
+	ApplyFixtures(t, "es.Midnight", w, fixt)
+}
@@ -0,0 +1,53 @@
+package es
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"esta mañana", "por la mañana" -> 8:00
+	"esta tarde" -> 15:00
+	"esta noche", "por la noche" -> 18:00
+
+	A bare "mañana" is tomorrow, so the article is required.
+*/
+
+func CasualTime(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"((?:esta|por\\s+la|en\\s+la|de\\s+la|la)\\s+" +
+			"(mañana|tarde|noche))" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			switch strings.ToLower(m.Captures[1]) {
+			case "mañana":
+				if o.Morning != 0 {
+					c.Hour = &o.Morning
+				} else {
+					c.Hour = pointer.ToInt(8)
+				}
+			case "tarde":
+				if o.Afternoon != 0 {
+					c.Hour = &o.Afternoon
+				} else {
+					c.Hour = pointer.ToInt(15)
+				}
+			case "noche":
+				if o.Evening != 0 {
+					c.Hour = &o.Evening
+				} else {
+					c.Hour = pointer.ToInt(18)
+				}
+			}
+			c.Minute = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
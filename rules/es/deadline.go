@@ -0,0 +1,17 @@
+package es
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+/*
+	"en 3 días"
+	"dentro de una hora y media"
+	"en media hora"
+	"en menos de dos semanas"
+*/
+
+func Deadline(s rules.Strategy) rules.Rule {
+	return romance.Deadline(s, `dentro\s+de|en\s+menos\s+de|en`, RELATIVE_WORDS)
+}
@@ -0,0 +1,38 @@
+package es_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/es"
+)
+
+func TestDeadline(t *testing.T) {
+	fixt := []Fixture{
+		{"en media hora", 0, "en media hora", time.Hour / 2},
+		{"en una hora", 0, "en una hora", time.Hour},
+		{"dentro de una hora y media", 0, "dentro de una hora y media", 90 * time.Minute},
+		{"en 5 minutos", 0, "en 5 minutos", 5 * time.Minute},
+		{"en un cuarto de hora", 0, "en un cuarto de hora", 15 * time.Minute},
+		{"hay que hacerlo en 3 días", 16, "en 3 días", 3 * 24 * time.Hour},
+		{"en un par de días", 0, "en un par de días", 2 * 24 * time.Hour},
+		{"en menos de dos semanas", 0, "en menos de dos semanas", 14 * 24 * time.Hour},
+		{"en un mes", 0, "en un mes", 31 * 24 * time.Hour},
+		{"dentro de unos meses", 0, "dentro de unos meses", 91 * 24 * time.Hour},
+		{"en un año", 0, "en un año", 366 * 24 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(es.Deadline(rules.Skip))
+
+	ApplyFixtures(t, "es.Deadline", w, fixt)
+
+	fixtnil := []Fixture{
+		{"en 2016", 0, "", 0},
+		{"en un momento", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "es.Deadline nil", w, fixtnil)
+}
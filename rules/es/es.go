@@ -0,0 +1,195 @@
+package es
+
+import (
+	_ "embed"
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/olebedev/when/rules/locale"
+	"github.com/olebedev/when/rules/romance"
+)
+
+var describe = rules.Describer("es")
+
+//go:embed es.json
+var vocabulary []byte
+
+// DATA holds the words of the language, see Extend
+var DATA = locale.MustLoad(vocabulary)
+
+var All []rules.Rule
+
+// the patterns of the tables, see build
+var (
+	WEEKDAY_OFFSET_PATTERN string
+	MONTH_OFFSET_PATTERN   string
+	INTEGER_WORDS_PATTERN  string
+	ORDINAL_WORDS_PATTERN  string
+)
+
+func init() {
+	build()
+}
+
+// Extend adds the words of ext to the language and rebuilds All, the
+// parsers made before keep the rules they have. Call it before making the
+// parsers, it isn't safe for concurrent use.
+func Extend(ext *locale.Data) {
+	DATA.Extend(ext)
+	build()
+}
+
+// build makes the patterns of the tables and the rules using them
+func build() {
+	WEEKDAY_OFFSET_PATTERN = locale.Pattern(WEEKDAY_OFFSET)
+	MONTH_OFFSET_PATTERN = locale.Pattern(MONTH_OFFSET)
+	INTEGER_WORDS_PATTERN = locale.Pattern(INTEGER_WORDS)
+	ORDINAL_WORDS_PATTERN = locale.Pattern(ORDINAL_WORDS)
+
+	WEEKDAY_WORDS.Pattern = WEEKDAY_OFFSET_PATTERN
+	MONTH_WORDS.Pattern = MONTH_OFFSET_PATTERN
+	MONTH_WORDS.OrdinalsPattern = ORDINAL_WORDS_PATTERN
+	RELATIVE_WORDS.AmountsPattern = `un\s+par\s+de|un\s+cuarto\s+de|unos|unas|algun[oa]s|poc[oa]s|vari[oa]s|medi[oa]|` + INTEGER_WORDS_PATTERN
+	CLOCK_WORDS.IntegersPattern = INTEGER_WORDS_PATTERN
+
+	All = []rules.Rule{
+		describe("weekday", rules.Date, 70, Weekday(rules.Override)),
+		describe("relative_week", rules.Range, 70, RelativeWeek(rules.Override)),
+		describe("casual_date", rules.Date, 60, CasualDate(rules.Override)),
+		describe("casual_time", rules.Time, 60, CasualTime(rules.Override)),
+		describe("midnight", rules.Time, 60, Midnight(rules.Override)),
+		describe("military_time", rules.Time, 50, common.MilitaryTime(rules.Override)),
+		describe("hour", rules.Time, 50, Hour(rules.Override)),
+		describe("hour_minute", rules.Time, 50, HourMinute(rules.Override)),
+		describe("deadline", rules.Duration, 40, Deadline(rules.Override)),
+		describe("past_time", rules.Duration, 40, PastTime(rules.Override)),
+		describe("exact_month_date", rules.Date, 30, ExactMonthDate(rules.Override)),
+	}
+}
+
+// CONNECTORS holds the words joining the parts of a time, like "a las" of
+// "mañana, después de la reunión, a las 3", see rules.ClusterByTokens
+var CONNECTORS = []string{"a", "las", "la", "el", "de", "del", "en", "por", "para"}
+
+// LABELS are the words labeling the numbers of the things, like "habitación" of
+// "habitación 1200", see STOPS
+var LABELS = []string{
+	"habitación", "sala", "versión", "nº", "n.º", "número", "pedido",
+	"vuelo", "página", "capítulo",
+}
+
+// STOPS keep the numbers of the things from being read as a time or a date,
+// like "habitación 1200" or "versión 2.0", see Parser.Stop of the when package
+var STOPS = []*regexp.Regexp{
+	rules.Labeled(LABELS...),
+}
+
+// the tables of the language, from es.json
+var (
+	WEEKDAY_OFFSET = DATA.Weekdays
+	MONTH_OFFSET   = DATA.Months
+	INTEGER_WORDS  = DATA.Numerals
+	ORDINAL_WORDS  = DATA.Ordinals
+)
+
+var WEEKDAY_WORDS = &romance.WeekdayWords{
+	Offsets:   WEEKDAY_OFFSET,
+	Articles:  `el`,
+	Next:      `próximo|proximo|siguiente`,
+	Last:      `último|ultimo|pasado`,
+	This:      `este`,
+	NextAfter: `próximo|proximo|que\s+viene|siguiente`,
+	LastAfter: `pasado`,
+	WeekStart: time.Monday,
+}
+
+var MONTH_WORDS = &romance.MonthWords{
+	Offsets:  MONTH_OFFSET,
+	Ordinals: ORDINAL_WORDS,
+	Of:       `de|del`,
+}
+
+var RELATIVE_WORDS = &romance.Relative{
+	Amounts: map[string]float64{
+		"un":           1,
+		"uno":          1,
+		"una":          1,
+		"dos":          2,
+		"tres":         3,
+		"cuatro":       4,
+		"cinco":        5,
+		"seis":         6,
+		"siete":        7,
+		"ocho":         8,
+		"nueve":        9,
+		"diez":         10,
+		"once":         11,
+		"doce":         12,
+		"quince":       15,
+		"veinte":       20,
+		"treinta":      30,
+		"unos":         3,
+		"unas":         3,
+		"algunos":      3,
+		"algunas":      3,
+		"pocos":        3,
+		"pocas":        3,
+		"varios":       3,
+		"varias":       3,
+		"medio":        0.5,
+		"media":        0.5,
+		"un par de":    2,
+		"un cuarto de": 0.25,
+	},
+	Units: map[string]romance.Unit{
+		"seg":    romance.Seconds,
+		"min":    romance.Minutes,
+		"h":      romance.Hours,
+		"día":    romance.Days,
+		"dia":    romance.Days,
+		"semana": romance.Weeks,
+		"mes":    romance.Months,
+		"año":    romance.Years,
+		"ano":    romance.Years,
+	},
+	UnitsPattern: `segundos?|seg|minutos?|min|horas?|h|días?|dias?|semanas?|meses|mes|años?|anos?`,
+	Half:         `y\s+medi[oa]`,
+}
+
+var CLOCK_WORDS = &romance.ClockWords{
+	At:       `a\s+las?|sobre\s+las?|hacia\s+las?|desde\s+las?|hasta\s+las?`,
+	Hours:    `horas?|h`,
+	Integers: INTEGER_WORDS,
+	Minutes: map[string]int{
+		"y media":      30,
+		"y cuarto":     15,
+		"menos cuarto": -15,
+	},
+	MinutesPattern: `y\s+media|y\s+cuarto|menos\s+cuarto`,
+	Morning:        `de\s+la\s+mañana|de\s+la\s+madrugada`,
+	Afternoon:      `de\s+la\s+tarde`,
+	Night:          `de\s+la\s+noche`,
+}
+
+var PERIOD_WORDS = &romance.PeriodWords{
+	Periods: map[string]common.Period{
+		"semana": common.Week,
+		"mes":    common.Month,
+		"año":    common.Year,
+	},
+	Pattern: `semana|mes|año`,
+	Shifts: map[string]int{
+		"próximo":   1,
+		"próxima":   1,
+		"que viene": 1,
+		"entrante":  1,
+		"pasado":    -1,
+		"pasada":    -1,
+		"este":      0,
+		"esta":      0,
+	},
+	Before: `próxim[oa]|pasad[oa]|est[ea]`,
+	After:  `que\s+viene|entrante|próxim[oa]|pasad[oa]`,
+}
@@ -0,0 +1,57 @@
+package es_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules/es"
+	"github.com/stretchr/testify/require"
+)
+
+var null = time.Date(2016, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+type Fixture struct {
+	Text   string
+	Index  int
+	Phrase string
+	Diff   time.Duration
+}
+
+func ApplyFixtures(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d", name, i)
+		require.NotNil(t, res, "[%s] res #%d", name, i)
+		require.Equal(t, f.Index, res.Index, "[%s] index #%d", name, i)
+		require.Equal(t, f.Phrase, res.Text, "[%s] text #%d", name, i)
+		require.Equal(t, f.Diff, res.Time.Sub(null), "[%s] diff #%d", name, i)
+	}
+}
+
+func ApplyFixturesNil(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d", name, i)
+		require.Nil(t, res, "[%s] res #%d", name, i)
+	}
+}
+
+func TestAll(t *testing.T) {
+	w := when.New(nil)
+	w.Add(es.All...)
+
+	// complex cases
+	fixt := []Fixture{
+		{"el próximo martes a las 14h", 3, "próximo martes a las 14h", ((6 * 24) + 14) * time.Hour},
+		{"pasado mañana a las 10:30", 0, "pasado mañana a las 10:30", ((2*24)+10)*time.Hour + 30*time.Minute},
+		{"mañana a las 8 de la noche", 0, "mañana a las 8 de la noche", (24 + 20) * time.Hour},
+		{"mañana por la mañana", 0, "mañana por la mañana", (24 + 8) * time.Hour},
+		{"la cita es el 5 de marzo a las 9", 14, "5 de marzo a las 9", (59*24 + 9) * time.Hour},
+		{"el viernes pasado a las 15 horas", 3, "viernes pasado a las 15 horas", ((-5 * 24) + 15) * time.Hour},
+		{"ayer a medianoche", 0, "ayer a medianoche", 0},
+		{"mañana al mediodía", 0, "mañana al mediodía", (24 + 12) * time.Hour},
+	}
+
+	ApplyFixtures(t, "es.All...", w, fixt)
+}
@@ -0,0 +1,17 @@
+package es
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+/*
+	"5 de marzo"
+	"el 1 de marzo de 2017"
+	"primero de abril"
+	"dic."
+*/
+
+func ExactMonthDate(s rules.Strategy) rules.Rule {
+	return romance.ExactMonthDate(s, MONTH_WORDS)
+}
@@ -0,0 +1,33 @@
+package es_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/es"
+)
+
+func TestExactMonthDate(t *testing.T) {
+	w := when.New(nil)
+	w.Add(es.ExactMonthDate(rules.Override))
+
+	fixt := []Fixture{
+		{"5 de marzo", 0, "5 de marzo", 59 * 24 * time.Hour},
+		{"el 5 marzo", 3, "5 marzo", 59 * 24 * time.Hour},
+		{"el 1 de marzo de 2017", 3, "1 de marzo de 2017", 420 * 24 * time.Hour},
+		{"primero de febrero", 0, "primero de febrero", 26 * 24 * time.Hour},
+		{"20 dic.", 0, "20 dic.", 349 * 24 * time.Hour},
+		{"agosto", 0, "agosto", 213 * 24 * time.Hour},
+	}
+
+	ApplyFixtures(t, "es.ExactMonthDate", w, fixt)
+
+	fixtnil := []Fixture{
+		{"mayonesa", 0, "", 0},
+		{"la marzoleta", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "es.ExactMonthDate nil", w, fixtnil)
+}
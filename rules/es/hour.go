@@ -0,0 +1,18 @@
+package es
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+/*
+	"15h", "a las 15 horas"
+	"a las tres de la tarde"
+	"a las 8 de la noche"
+	"a las tres y media"
+	"a las 5 menos cuarto"
+*/
+
+func Hour(s rules.Strategy) rules.Rule {
+	return romance.Hour(s, CLOCK_WORDS)
+}
@@ -0,0 +1,15 @@
+package es
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+/*
+	"14:30", "14h30"
+	"a las 8:15 de la noche"
+*/
+
+func HourMinute(s rules.Strategy) rules.Rule {
+	return romance.HourMinute(s, CLOCK_WORDS)
+}
@@ -0,0 +1,31 @@
+package es_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/es"
+)
+
+func TestHourMinute(t *testing.T) {
+	fixt := []Fixture{
+		{"14:30", 0, "14:30", 14*time.Hour + 30*time.Minute},
+		{"a las 14h30", 0, "a las 14h30", 14*time.Hour + 30*time.Minute},
+		{"a las 8:15 de la noche", 0, "a las 8:15 de la noche", 20*time.Hour + 15*time.Minute},
+		{"0:05", 0, "0:05", 5 * time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Add(es.HourMinute(rules.Override))
+
+	ApplyFixtures(t, "es.HourMinute", w, fixt)
+
+	fixtnil := []Fixture{
+		{"24:30", 0, "", 0},
+		{"14:75", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "es.HourMinute nil", w, fixtnil)
+}
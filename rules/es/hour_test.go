@@ -0,0 +1,39 @@
+package es_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/es"
+)
+
+func TestHour(t *testing.T) {
+	fixt := []Fixture{
+		{"15h", 0, "15h", 15 * time.Hour},
+		{"a las 15 horas", 0, "a las 15 horas", 15 * time.Hour},
+		{"a las tres", 0, "a las tres", 3 * time.Hour},
+		{"a las 3 de la tarde", 0, "a las 3 de la tarde", 15 * time.Hour},
+		{"a las 8 de la noche", 0, "a las 8 de la noche", 20 * time.Hour},
+		{"a las 12 de la noche", 0, "a las 12 de la noche", 24 * time.Hour},
+		{"a las 6 de la mañana", 0, "a las 6 de la mañana", 6 * time.Hour},
+		{"hacia la una de la madrugada", 0, "hacia la una de la madrugada", time.Hour},
+		{"a las tres y media", 0, "a las tres y media", 3*time.Hour + 30*time.Minute},
+		{"a las 10 y cuarto", 0, "a las 10 y cuarto", 10*time.Hour + 15*time.Minute},
+		{"a las 5 menos cuarto", 0, "a las 5 menos cuarto", 4*time.Hour + 45*time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Add(es.Hour(rules.Override))
+
+	ApplyFixtures(t, "es.Hour", w, fixt)
+
+	fixtnil := []Fixture{
+		{"15h30", 0, "", 0},
+		{"tres", 0, "", 0},
+		{"a las 25", 0, "a las 25", 0},
+	}
+
+	ApplyFixturesNil(t, "es.Hour nil", w, fixtnil)
+}
@@ -0,0 +1,44 @@
+package es
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"a medianoche" -> the end of today
+	"el viernes a medianoche" -> the end of friday, 00:00 on saturday
+	"mañana al mediodía" -> 12:00 tomorrow
+
+	A midnight belongs to the end of its day unless Options.Midnight is set
+	to rules.MidnightStartOfDay, the one of "esta noche" is always at its
+	end.
+*/
+
+// Midnight handles midnight and noon
+func Midnight(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile(`(?i)(?:\P{L}|^)((?:(?:a|al|a\s+la)\s+)?(medianoche|mediod[íi]a))(?:\P{L}|$)`),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			if strings.ToLower(m.Captures[1]) == "medianoche" {
+				c.Hour = pointer.ToInt(o.MidnightHour())
+				if regexp.MustCompile(`(?i)esta\s+noche`).MatchString(c.Text) {
+					c.Hour = pointer.ToInt(24)
+				}
+			} else if o.Noon != 0 {
+				c.Hour = &o.Noon
+			} else {
+				c.Hour = pointer.ToInt(12)
+			}
+			c.Minute = pointer.ToInt(0)
+			c.Second = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,16 @@
+package es
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+/*
+	"hace 3 días"
+	"hace media hora"
+	"dos semanas atrás"
+*/
+
+func PastTime(s rules.Strategy) rules.Rule {
+	return romance.PastTime(s, `hace`, `atrás|atras`, RELATIVE_WORDS)
+}
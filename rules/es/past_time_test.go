@@ -0,0 +1,27 @@
+package es_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/es"
+)
+
+func TestPastTime(t *testing.T) {
+	fixt := []Fixture{
+		{"hace media hora", 0, "hace media hora", -(time.Hour / 2)},
+		{"hace una hora", 0, "hace una hora", -(time.Hour)},
+		{"hace 5 minutos", 0, "hace 5 minutos", -(5 * time.Minute)},
+		{"fue hace tres días", 4, "hace tres días", -(3 * 24 * time.Hour)},
+		{"dos semanas atrás", 0, "dos semanas atrás", -(14 * 24 * time.Hour)},
+		{"hace un mes", 0, "hace un mes", -(31 * 24 * time.Hour)},
+		{"hace un año", 0, "hace un año", -(365 * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(es.PastTime(rules.Skip))
+
+	ApplyFixtures(t, "es.PastTime", w, fixt)
+}
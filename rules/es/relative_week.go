@@ -0,0 +1,18 @@
+package es
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+/*
+	"la semana que viene", "la próxima semana" -> 9:00 on the next Monday
+	"la semana pasada" -> -7 days
+	"el mes que viene" -> the first day of the next month
+	"el mes pasado" -> the previous month
+	"este año" -> the current year
+*/
+
+func RelativeWeek(s rules.Strategy) rules.Rule {
+	return romance.RelativeWeek(s, PERIOD_WORDS)
+}
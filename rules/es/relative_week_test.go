@@ -0,0 +1,35 @@
+package es_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/es"
+)
+
+func TestRelativeWeek(t *testing.T) {
+	fixt := []Fixture{
+		{"la semana que viene", 3, "semana que viene", (5*24 + 9) * time.Hour},
+		{"la próxima semana", 3, "próxima semana", (5*24 + 9) * time.Hour},
+		{"fue la semana pasada", 7, "semana pasada", -(7 * 24 * time.Hour)},
+		{"esta semana", 0, "esta semana", 0},
+		{"el mes que viene", 3, "mes que viene", (26*24 + 9) * time.Hour},
+		{"el mes pasado", 3, "mes pasado", -(31 * 24 * time.Hour)},
+		{"el año que viene", 3, "año que viene", 366 * 24 * time.Hour},
+		{"este año", 0, "este año", 0},
+	}
+
+	w := when.New(nil)
+	w.Add(es.RelativeWeek(rules.Override))
+
+	ApplyFixtures(t, "es.RelativeWeek", w, fixt)
+
+	fixtnil := []Fixture{
+		{"semana", 0, "", 0},
+		{"el mes de marzo", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "es.RelativeWeek nil", w, fixtnil)
+}
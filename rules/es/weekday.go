@@ -0,0 +1,17 @@
+package es
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+/*
+	"lunes", "el lunes" -> the upcoming Monday
+	"el próximo martes", "el martes que viene" -> the upcoming Tuesday
+	"el viernes pasado" -> the past Friday
+	"este lunes" -> Monday of the current week
+*/
+
+func Weekday(s rules.Strategy) rules.Rule {
+	return romance.Weekday(s, WEEKDAY_WORDS)
+}
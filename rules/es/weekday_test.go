@@ -0,0 +1,36 @@
+package es_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/es"
+)
+
+func TestWeekday(t *testing.T) {
+	// current is Wednesday
+	fixt := []Fixture{
+		// upcoming
+		{"lunes", 0, "lunes", 5 * 24 * time.Hour},
+		{"el viernes", 3, "viernes", 2 * 24 * time.Hour},
+		{"miércoles", 0, "miércoles", 7 * 24 * time.Hour},
+		// next
+		{"el próximo martes", 3, "próximo martes", 6 * 24 * time.Hour},
+		{"llámame el sábado que viene", 12, "sábado que viene", 3 * 24 * time.Hour},
+		{"el jueves siguiente", 3, "jueves siguiente", 24 * time.Hour},
+		// last
+		{"el viernes pasado", 3, "viernes pasado", -(5 * 24 * time.Hour)},
+		{"el miercoles pasado", 3, "miercoles pasado", -(7 * 24 * time.Hour)},
+		{"el último lunes", 3, "último lunes", -(2 * 24 * time.Hour)},
+		// this
+		{"este lunes", 0, "este lunes", -(2 * 24 * time.Hour)},
+		{"este domingo", 0, "este domingo", 4 * 24 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(es.Weekday(rules.Override))
+
+	ApplyFixtures(t, "es.Weekday", w, fixt)
+}
@@ -0,0 +1,40 @@
+package fr
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"maintenant", "aujourd'hui" -> today
+	"demain" -> tomorrow
+	"après-demain" -> the day after tomorrow
+	"hier" -> yesterday
+	"avant-hier" -> the day before yesterday
+*/
+
+func CasualDate(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"(maintenant|tout\\s+de\\s+suite|aujourd['’]hui|apr[èe]s-demain|demain|avant-hier|hier)" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			switch lower := strings.ToLower(m.Captures[0]); {
+			case strings.HasSuffix(lower, "-demain"):
+				c.Duration = 2 * 24 * time.Hour
+			case lower == "demain":
+				c.Duration = 24 * time.Hour
+			case lower == "avant-hier":
+				c.Duration = -(2 * 24 * time.Hour)
+			case lower == "hier":
+				c.Duration = -(24 * time.Hour)
+			}
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
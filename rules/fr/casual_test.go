@@ -0,0 +1,75 @@
+package fr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/fr"
+)
+
+func TestCasualDate(t *testing.T) {
+	fixt := []Fixture{
+		{"La date limite est maintenant", 19, "maintenant", 0},
+		{"La date limite est aujourd'hui", 19, "aujourd'hui", 0},
+		{"La date limite est demain", 19, "demain", 24 * time.Hour},
+		{"La date limite est après-demain", 19, "après-demain", 2 * 24 * time.Hour},
+		{"La date limite était hier", 22, "hier", -(24 * time.Hour)},
+		{"La date limite était avant-hier", 22, "avant-hier", -(2 * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(fr.CasualDate(rules.Skip))
+
+	ApplyFixtures(t, "fr.CasualDate", w, fixt)
+}
+
+func TestCasualTime(t *testing.T) {
+	fixt := []Fixture{
+		{"La date limite était ce matin", 22, "ce matin", 8 * time.Hour},
+		{"La date limite est cet après-midi", 19, "cet après-midi", 15 * time.Hour},
+		{"La date limite est ce soir", 19, "ce soir", 18 * time.Hour},
+		{"La date limite est dans la soirée", 19, "dans la soirée", 18 * time.Hour},
+		{"La date limite est cette nuit", 19, "cette nuit", 23 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(fr.CasualTime(rules.Skip))
+
+	ApplyFixtures(t, "fr.CasualTime", w, fixt)
+}
+
+func TestCasualDateCasualTime(t *testing.T) {
+	fixt := []Fixture{
+		{"La date limite est demain matin", 19, "demain matin", (24 + 8) * time.Hour},
+		{"La date limite était hier soir", 22, "hier soir", -(6 * time.Hour)},
+		{"La date limite est après-demain dans l'après-midi", 19, "après-demain dans l'après-midi", (2*24 + 15) * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(
+		fr.CasualDate(rules.Skip),
+		fr.CasualTime(rules.Override),
+	)
+
+	ApplyFixtures(t, "fr.CasualDate|fr.CasualTime", w, fixt)
+}
+
+func TestMidnight(t *testing.T) {
+	fixt := []Fixture{
+		{"à minuit", 0, "à minuit", 24 * time.Hour},
+		{"vers midi", 5, "midi", 12 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(fr.Midnight(rules.Override))
+
+	ApplyFixtures(t, "fr.Midnight", w, fixt)
+
+	fixtnil := []Fixture{
+		{"l'après-midi", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "fr.Midnight nil", w, fixtnil)
+}
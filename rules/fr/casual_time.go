@@ -0,0 +1,54 @@
+package fr
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"ce matin" -> 8:00
+	"cet après-midi" -> 15:00
+	"ce soir", "dans la soirée" -> 18:00
+	"cette nuit" -> 23:00
+*/
+
+func CasualTime(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"((?:ce|cet|cette|le|la|l['’]|en|dans\\s+la|dans\\s+l['’])\\s*)?" +
+			"(matinée|matin|apr[èe]s-midi|soirée|soir|nuit)" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			switch lower := strings.ToLower(m.Captures[1]); {
+			case strings.HasPrefix(lower, "matin"):
+				if o.Morning != 0 {
+					c.Hour = &o.Morning
+				} else {
+					c.Hour = pointer.ToInt(8)
+				}
+			case strings.HasSuffix(lower, "midi"):
+				if o.Afternoon != 0 {
+					c.Hour = &o.Afternoon
+				} else {
+					c.Hour = pointer.ToInt(15)
+				}
+			case strings.HasPrefix(lower, "soir"):
+				if o.Evening != 0 {
+					c.Hour = &o.Evening
+				} else {
+					c.Hour = pointer.ToInt(18)
+				}
+			case lower == "nuit":
+				c.Hour = pointer.ToInt(23)
+			}
+			c.Minute = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
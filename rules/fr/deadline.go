@@ -0,0 +1,17 @@
+package fr
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+/*
+	"dans 3 jours"
+	"dans une heure et demie"
+	"d'ici une demi-heure"
+	"en deux semaines"
+*/
+
+func Deadline(s rules.Strategy) rules.Rule {
+	return romance.Deadline(s, `dans|d['’]ici|sous|en`, RELATIVE_WORDS)
+}
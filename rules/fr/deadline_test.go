@@ -0,0 +1,38 @@
+package fr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/fr"
+)
+
+func TestDeadline(t *testing.T) {
+	fixt := []Fixture{
+		{"dans une demi-heure", 0, "dans une demi-heure", time.Hour / 2},
+		{"dans une heure", 0, "dans une heure", time.Hour},
+		{"dans une heure et demie", 0, "dans une heure et demie", 90 * time.Minute},
+		{"dans 5 minutes", 0, "dans 5 minutes", 5 * time.Minute},
+		{"d'ici un quart d'heure", 0, "d'ici un quart d'heure", 15 * time.Minute},
+		{"il faut le faire dans 3 jours", 17, "dans 3 jours", 3 * 24 * time.Hour},
+		{"en deux semaines", 0, "en deux semaines", 14 * 24 * time.Hour},
+		{"dans 2h", 0, "dans 2h", 2 * time.Hour},
+		{"dans un mois", 0, "dans un mois", 31 * 24 * time.Hour},
+		{"dans quelques mois", 0, "dans quelques mois", 91 * 24 * time.Hour},
+		{"dans un an", 0, "dans un an", 366 * 24 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(fr.Deadline(rules.Skip))
+
+	ApplyFixtures(t, "fr.Deadline", w, fixt)
+
+	fixtnil := []Fixture{
+		{"en 2016", 0, "", 0},
+		{"dans un moment", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "fr.Deadline nil", w, fixtnil)
+}
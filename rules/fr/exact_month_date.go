@@ -0,0 +1,17 @@
+package fr
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+/*
+	"5 mars"
+	"le 1er mars 2017"
+	"premier avril"
+	"déc."
+*/
+
+func ExactMonthDate(s rules.Strategy) rules.Rule {
+	return romance.ExactMonthDate(s, MONTH_WORDS)
+}
@@ -0,0 +1,33 @@
+package fr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/fr"
+)
+
+func TestExactMonthDate(t *testing.T) {
+	w := when.New(nil)
+	w.Add(fr.ExactMonthDate(rules.Override))
+
+	fixt := []Fixture{
+		{"5 mars", 0, "5 mars", 59 * 24 * time.Hour},
+		{"le 5 mars", 3, "5 mars", 59 * 24 * time.Hour},
+		{"le 1er mars 2017", 3, "1er mars 2017", 420 * 24 * time.Hour},
+		{"premier février", 0, "premier février", 26 * 24 * time.Hour},
+		{"20 déc.", 0, "20 déc.", 349 * 24 * time.Hour},
+		{"août", 0, "août", 213 * 24 * time.Hour},
+	}
+
+	ApplyFixtures(t, "fr.ExactMonthDate", w, fixt)
+
+	fixtnil := []Fixture{
+		{"marsupial", 0, "", 0},
+		{"la maison", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "fr.ExactMonthDate nil", w, fixtnil)
+}
@@ -0,0 +1,191 @@
+package fr
+
+import (
+	_ "embed"
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/olebedev/when/rules/locale"
+	"github.com/olebedev/when/rules/romance"
+)
+
+var describe = rules.Describer("fr")
+
+//go:embed fr.json
+var vocabulary []byte
+
+// DATA holds the words of the language, see Extend
+var DATA = locale.MustLoad(vocabulary)
+
+var All []rules.Rule
+
+// the patterns of the tables, see build
+var (
+	WEEKDAY_OFFSET_PATTERN string
+	MONTH_OFFSET_PATTERN   string
+	INTEGER_WORDS_PATTERN  string
+	ORDINAL_WORDS_PATTERN  string
+)
+
+func init() {
+	build()
+}
+
+// Extend adds the words of ext to the language and rebuilds All, the
+// parsers made before keep the rules they have. Call it before making the
+// parsers, it isn't safe for concurrent use.
+func Extend(ext *locale.Data) {
+	DATA.Extend(ext)
+	build()
+}
+
+// build makes the patterns of the tables and the rules using them
+func build() {
+	WEEKDAY_OFFSET_PATTERN = locale.Pattern(WEEKDAY_OFFSET)
+	MONTH_OFFSET_PATTERN = locale.Pattern(MONTH_OFFSET)
+	INTEGER_WORDS_PATTERN = locale.Pattern(INTEGER_WORDS)
+	ORDINAL_WORDS_PATTERN = locale.Pattern(ORDINAL_WORDS)
+
+	WEEKDAY_WORDS.Pattern = WEEKDAY_OFFSET_PATTERN
+	MONTH_WORDS.Pattern = MONTH_OFFSET_PATTERN
+	MONTH_WORDS.OrdinalsPattern = ORDINAL_WORDS_PATTERN
+	RELATIVE_WORDS.AmountsPattern = `une\s+demie?|demi|un\s+quart\s+d['’]|quelques|plusieurs|` + INTEGER_WORDS_PATTERN
+	CLOCK_WORDS.IntegersPattern = INTEGER_WORDS_PATTERN
+
+	All = []rules.Rule{
+		describe("weekday", rules.Date, 70, Weekday(rules.Override)),
+		describe("relative_week", rules.Range, 70, RelativeWeek(rules.Override)),
+		describe("casual_date", rules.Date, 60, CasualDate(rules.Override)),
+		describe("casual_time", rules.Time, 60, CasualTime(rules.Override)),
+		describe("midnight", rules.Time, 60, Midnight(rules.Override)),
+		describe("military_time", rules.Time, 50, common.MilitaryTime(rules.Override)),
+		describe("hour", rules.Time, 50, Hour(rules.Override)),
+		describe("hour_minute", rules.Time, 50, HourMinute(rules.Override)),
+		describe("deadline", rules.Duration, 40, Deadline(rules.Override)),
+		describe("past_time", rules.Duration, 40, PastTime(rules.Override)),
+		describe("exact_month_date", rules.Date, 30, ExactMonthDate(rules.Override)),
+	}
+}
+
+// CONNECTORS holds the words joining the parts of a time, like "à" of
+// "demain, après la réunion, à 15h", see rules.ClusterByTokens
+var CONNECTORS = []string{"à", "a", "le", "la", "de", "du", "en", "vers", "pour"}
+
+// LABELS are the words labeling the numbers of the things, like "chambre" of
+// "chambre 1200", see STOPS
+var LABELS = []string{
+	"chambre", "salle", "version", "n°", "no", "numéro", "commande",
+	"vol", "page", "chapitre", "billet",
+}
+
+// STOPS keep the numbers of the things from being read as a time or a date,
+// like "chambre 1200" or "version 2.0", see Parser.Stop of the when package
+var STOPS = []*regexp.Regexp{
+	rules.Labeled(LABELS...),
+}
+
+// the tables of the language, from fr.json
+var (
+	WEEKDAY_OFFSET = DATA.Weekdays
+	MONTH_OFFSET   = DATA.Months
+	INTEGER_WORDS  = DATA.Numerals
+	ORDINAL_WORDS  = DATA.Ordinals
+)
+
+var WEEKDAY_WORDS = &romance.WeekdayWords{
+	Offsets:   WEEKDAY_OFFSET,
+	Articles:  `le|au`,
+	Next:      `prochain`,
+	Last:      `dernier`,
+	This:      `ce`,
+	NextAfter: `prochain|qui\s+vient`,
+	LastAfter: `dernier|passé`,
+	WeekStart: time.Monday,
+}
+
+var MONTH_WORDS = &romance.MonthWords{
+	Offsets:  MONTH_OFFSET,
+	Ordinals: ORDINAL_WORDS,
+}
+
+var RELATIVE_WORDS = &romance.Relative{
+	Amounts: map[string]float64{
+		"un":          1,
+		"une":         1,
+		"deux":        2,
+		"trois":       3,
+		"quatre":      4,
+		"cinq":        5,
+		"six":         6,
+		"sept":        7,
+		"huit":        8,
+		"neuf":        9,
+		"dix":         10,
+		"onze":        11,
+		"douze":       12,
+		"quinze":      15,
+		"vingt":       20,
+		"trente":      30,
+		"quelques":    3,
+		"plusieurs":   3,
+		"demi":        0.5,
+		"une demi":    0.5,
+		"une demie":   0.5,
+		"un quart d'": 0.25,
+		"un quart d’": 0.25,
+	},
+	Units: map[string]romance.Unit{
+		"sec":     romance.Seconds,
+		"min":     romance.Minutes,
+		"h":       romance.Hours,
+		"jour":    romance.Days,
+		"semaine": romance.Weeks,
+		"mois":    romance.Months,
+		"an":      romance.Years,
+	},
+	UnitsPattern: `secondes?|sec|minutes?|min|heures?|h|journées?|jours?|semaines?|mois|années?|ans?`,
+	Half:         `et\s+demie?`,
+}
+
+var CLOCK_WORDS = &romance.ClockWords{
+	Hours:    `heures?|h`,
+	Integers: INTEGER_WORDS,
+	Minutes: map[string]int{
+		"et demie":       30,
+		"et demi":        30,
+		"et quart":       15,
+		"moins le quart": -15,
+		"moins quart":    -15,
+	},
+	MinutesPattern: `et\s+demie?|et\s+quart|moins\s+(?:le\s+)?quart`,
+	Morning:        `du\s+matin`,
+	Afternoon:      `de\s+l['’]\s*après-midi|du\s+soir`,
+	Night:          `de\s+la\s+nuit`,
+}
+
+var PERIOD_WORDS = &romance.PeriodWords{
+	Periods: map[string]common.Period{
+		"semaine": common.Week,
+		"mois":    common.Month,
+		"année":   common.Year,
+		"an":      common.Year,
+	},
+	Pattern: `semaine|mois|année|an`,
+	Shifts: map[string]int{
+		"prochain":  1,
+		"prochaine": 1,
+		"suivant":   1,
+		"suivante":  1,
+		"dernier":   -1,
+		"dernière":  -1,
+		"passé":     -1,
+		"passée":    -1,
+		"ce":        0,
+		"cet":       0,
+		"cette":     0,
+	},
+	Before: `prochaine?|cette|cet|ce`,
+	After:  `prochaine?|suivante?|dernière|dernier|passée?`,
+}
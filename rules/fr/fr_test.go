@@ -0,0 +1,56 @@
+package fr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules/fr"
+	"github.com/stretchr/testify/require"
+)
+
+var null = time.Date(2016, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+type Fixture struct {
+	Text   string
+	Index  int
+	Phrase string
+	Diff   time.Duration
+}
+
+func ApplyFixtures(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d", name, i)
+		require.NotNil(t, res, "[%s] res #%d", name, i)
+		require.Equal(t, f.Index, res.Index, "[%s] index #%d", name, i)
+		require.Equal(t, f.Phrase, res.Text, "[%s] text #%d", name, i)
+		require.Equal(t, f.Diff, res.Time.Sub(null), "[%s] diff #%d", name, i)
+	}
+}
+
+func ApplyFixturesNil(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d", name, i)
+		require.Nil(t, res, "[%s] res #%d", name, i)
+	}
+}
+
+func TestAll(t *testing.T) {
+	w := when.New(nil)
+	w.Add(fr.All...)
+
+	// complex cases
+	fixt := []Fixture{
+		{"mardi prochain à 14h", 0, "mardi prochain à 14h", ((6 * 24) + 14) * time.Hour},
+		{"après-demain à 10h30", 0, "après-demain à 10h30", ((2*24)+10)*time.Hour + 30*time.Minute},
+		{"demain à 8 heures du soir", 0, "demain à 8 heures du soir", (24 + 20) * time.Hour},
+		{"rendez-vous le 5 mars à 9h", 15, "5 mars à 9h", (59*24 + 9) * time.Hour},
+		{"vendredi dernier à 15 heures", 0, "vendredi dernier à 15 heures", ((-5 * 24) + 15) * time.Hour},
+		{"hier à minuit", 0, "hier à minuit", 0},
+		{"demain à midi", 0, "demain à midi", (24 + 12) * time.Hour},
+	}
+
+	ApplyFixtures(t, "fr.All...", w, fixt)
+}
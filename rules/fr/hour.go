@@ -0,0 +1,18 @@
+package fr
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+/*
+	"15h", "à 15 heures"
+	"3 heures de l'après-midi"
+	"8 heures du soir"
+	"trois heures et demie"
+	"5 heures moins le quart"
+*/
+
+func Hour(s rules.Strategy) rules.Rule {
+	return romance.Hour(s, CLOCK_WORDS)
+}
@@ -0,0 +1,16 @@
+package fr
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+/*
+	"14h30", "14 h 30"
+	"14:30"
+	"8h15 du soir"
+*/
+
+func HourMinute(s rules.Strategy) rules.Rule {
+	return romance.HourMinute(s, CLOCK_WORDS)
+}
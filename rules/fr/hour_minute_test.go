@@ -0,0 +1,32 @@
+package fr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/fr"
+)
+
+func TestHourMinute(t *testing.T) {
+	fixt := []Fixture{
+		{"14h30", 0, "14h30", 14*time.Hour + 30*time.Minute},
+		{"à 14 h 30", 3, "14 h 30", 14*time.Hour + 30*time.Minute},
+		{"14:30", 0, "14:30", 14*time.Hour + 30*time.Minute},
+		{"à 8h15 du soir", 3, "8h15 du soir", 20*time.Hour + 15*time.Minute},
+		{"0h05", 0, "0h05", 5 * time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Add(fr.HourMinute(rules.Override))
+
+	ApplyFixtures(t, "fr.HourMinute", w, fixt)
+
+	fixtnil := []Fixture{
+		{"24h30", 0, "", 0},
+		{"14h75", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "fr.HourMinute nil", w, fixtnil)
+}
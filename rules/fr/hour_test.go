@@ -0,0 +1,38 @@
+package fr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/fr"
+)
+
+func TestHour(t *testing.T) {
+	fixt := []Fixture{
+		{"15h", 0, "15h", 15 * time.Hour},
+		{"à 15 heures", 3, "15 heures", 15 * time.Hour},
+		{"à trois heures", 3, "trois heures", 3 * time.Hour},
+		{"3 heures de l'après-midi", 0, "3 heures de l'après-midi", 15 * time.Hour},
+		{"8 heures du soir", 0, "8 heures du soir", 20 * time.Hour},
+		{"12h du matin", 0, "12h du matin", 0},
+		{"11 heures de la nuit", 0, "11 heures de la nuit", 23 * time.Hour},
+		{"trois heures et demie", 0, "trois heures et demie", 3*time.Hour + 30*time.Minute},
+		{"10h et quart", 0, "10h et quart", 10*time.Hour + 15*time.Minute},
+		{"5 heures moins le quart", 0, "5 heures moins le quart", 4*time.Hour + 45*time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Add(fr.Hour(rules.Override))
+
+	ApplyFixtures(t, "fr.Hour", w, fixt)
+
+	fixtnil := []Fixture{
+		{"15h30", 0, "", 0},
+		{"à 5 km", 0, "", 0},
+		{"25h", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "fr.Hour nil", w, fixtnil)
+}
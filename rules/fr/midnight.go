@@ -0,0 +1,44 @@
+package fr
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"à minuit" -> the end of today
+	"vendredi à minuit" -> the end of friday, 00:00 on saturday
+	"demain à midi" -> 12:00 tomorrow
+
+	A midnight belongs to the end of its day unless Options.Midnight is set
+	to rules.MidnightStartOfDay, the one of "cette nuit" is always at its end.
+	The "midi" of "après-midi" is not noon.
+*/
+
+// Midnight handles midnight and noon
+func Midnight(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile(`(?i)(?:[^\p{L}-]|^)((?:à\s+)?(minuit|midi))(?:[^\p{L}-]|$)`),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			if strings.ToLower(m.Captures[1]) == "minuit" {
+				c.Hour = pointer.ToInt(o.MidnightHour())
+				if regexp.MustCompile(`(?i)cette\s+nuit`).MatchString(c.Text) {
+					c.Hour = pointer.ToInt(24)
+				}
+			} else if o.Noon != 0 {
+				c.Hour = &o.Noon
+			} else {
+				c.Hour = pointer.ToInt(12)
+			}
+			c.Minute = pointer.ToInt(0)
+			c.Second = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,16 @@
+package fr
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+/*
+	"il y a 3 jours"
+	"il y a une demi-heure"
+	"deux semaines auparavant"
+*/
+
+func PastTime(s rules.Strategy) rules.Rule {
+	return romance.PastTime(s, `il\s+y\s+a`, `auparavant|plus\s+tôt`, RELATIVE_WORDS)
+}
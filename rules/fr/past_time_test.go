@@ -0,0 +1,27 @@
+package fr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/fr"
+)
+
+func TestPastTime(t *testing.T) {
+	fixt := []Fixture{
+		{"il y a une demi-heure", 0, "il y a une demi-heure", -(time.Hour / 2)},
+		{"il y a une heure", 0, "il y a une heure", -(time.Hour)},
+		{"il y a 5 minutes", 0, "il y a 5 minutes", -(5 * time.Minute)},
+		{"c'était il y a trois jours", 9, "il y a trois jours", -(3 * 24 * time.Hour)},
+		{"deux semaines auparavant", 0, "deux semaines auparavant", -(14 * 24 * time.Hour)},
+		{"il y a un mois", 0, "il y a un mois", -(31 * 24 * time.Hour)},
+		{"il y a un an", 0, "il y a un an", -(365 * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(fr.PastTime(rules.Skip))
+
+	ApplyFixtures(t, "fr.PastTime", w, fixt)
+}
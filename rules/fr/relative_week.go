@@ -0,0 +1,18 @@
+package fr
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+/*
+	"la semaine prochaine" -> 9:00 on the next Monday
+	"la semaine dernière" -> -7 days
+	"le mois prochain" -> the first day of the next month
+	"le mois dernier" -> the previous month
+	"cette année" -> the current year
+*/
+
+func RelativeWeek(s rules.Strategy) rules.Rule {
+	return romance.RelativeWeek(s, PERIOD_WORDS)
+}
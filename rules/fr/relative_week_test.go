@@ -0,0 +1,28 @@
+package fr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/fr"
+)
+
+func TestRelativeWeek(t *testing.T) {
+	fixt := []Fixture{
+		{"la semaine prochaine", 3, "semaine prochaine", (5*24 + 9) * time.Hour},
+		{"c'était la semaine dernière", 12, "semaine dernière", -(7 * 24 * time.Hour)},
+		{"cette semaine", 0, "cette semaine", 0},
+		{"le mois prochain", 3, "mois prochain", (26*24 + 9) * time.Hour},
+		{"le mois dernier", 3, "mois dernier", -(31 * 24 * time.Hour)},
+		{"l'année prochaine", 2, "année prochaine", 366 * 24 * time.Hour},
+		{"l'an dernier", 2, "an dernier", -(365 * 24 * time.Hour)},
+		{"cette année", 0, "cette année", 0},
+	}
+
+	w := when.New(nil)
+	w.Add(fr.RelativeWeek(rules.Override))
+
+	ApplyFixtures(t, "fr.RelativeWeek", w, fixt)
+}
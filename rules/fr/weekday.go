@@ -0,0 +1,17 @@
+package fr
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+/*
+	"lundi" -> the upcoming Monday
+	"mardi prochain" -> the upcoming Tuesday
+	"vendredi dernier" -> the past Friday
+	"ce lundi" -> Monday of the current week
+*/
+
+func Weekday(s rules.Strategy) rules.Rule {
+	return romance.Weekday(s, WEEKDAY_WORDS)
+}
@@ -0,0 +1,36 @@
+package fr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/fr"
+)
+
+func TestWeekday(t *testing.T) {
+	// current is Wednesday
+	fixt := []Fixture{
+		// upcoming
+		{"lundi", 0, "lundi", 5 * 24 * time.Hour},
+		{"le vendredi", 3, "vendredi", 2 * 24 * time.Hour},
+		{"mercredi", 0, "mercredi", 7 * 24 * time.Hour},
+		// next
+		{"mardi prochain", 0, "mardi prochain", 6 * 24 * time.Hour},
+		{"appelle-moi samedi qui vient", 12, "samedi qui vient", 3 * 24 * time.Hour},
+		{"le prochain jeudi", 3, "prochain jeudi", 24 * time.Hour},
+		// last
+		{"vendredi dernier", 0, "vendredi dernier", -(5 * 24 * time.Hour)},
+		{"mercredi passé", 0, "mercredi passé", -(7 * 24 * time.Hour)},
+		{"le dernier lundi", 3, "dernier lundi", -(2 * 24 * time.Hour)},
+		// this
+		{"ce lundi", 0, "ce lundi", -(2 * 24 * time.Hour)},
+		{"ce dimanche", 0, "ce dimanche", 4 * 24 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(fr.Weekday(rules.Override))
+
+	ApplyFixtures(t, "fr.Weekday", w, fixt)
+}
@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Stops holds the borders of the parts of a text no rule matches in, like
+// "may I" or "room 1200", see FindStops
+type Stops [][]int
+
+// FindStops returns the borders of the matches of the patterns in the text,
+// the ones of the first group of a pattern having any, so a pattern can
+// tell the words around a stop without taking them. A match the first group
+// took no part in stops as a whole.
+func FindStops(text string, patterns ...*regexp.Regexp) Stops {
+	var s Stops
+	for _, p := range patterns {
+		for _, b := range p.FindAllStringSubmatchIndex(text, -1) {
+			if len(b) > 2 && b[2] >= 0 {
+				b = b[2:4]
+			}
+			s = append(s, b[:2])
+		}
+	}
+	return s
+}
+
+// Cover tells if the match overlaps any of the stops
+func (s Stops) Cover(m *Match) bool {
+	for _, b := range s {
+		if m.Left < b[1] && b[0] < m.Right {
+			return true
+		}
+	}
+	return false
+}
+
+// Enclose tells if the match lies within any of the stops
+func (s Stops) Enclose(m *Match) bool {
+	for _, b := range s {
+		if b[0] <= m.Left && m.Right <= b[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// Guard returns the rule dropping its matches lying within a match of any
+// of the patterns, like the "may" of "may I ask" for a rule of months. The
+// patterns see the whole text, so they tell the words around a match. A
+// match reaching out of them has more than the guarded words, like the day
+// of "this may 3rd", and is kept.
+func Guard(r Rule, patterns ...*regexp.Regexp) Rule {
+	return &guarded{Rule: r, patterns: patterns}
+}
+
+type guarded struct {
+	Rule
+	patterns []*regexp.Regexp
+}
+
+func (g *guarded) Find(text string) *Match {
+	if found := g.FindAll(text); len(found) > 0 {
+		return found[0]
+	}
+	return nil
+}
+
+func (g *guarded) FindAll(text string) []*Match {
+	found := FindAll(g.Rule, text)
+	if len(found) == 0 {
+		return nil
+	}
+
+	stops := FindStops(text, g.patterns...)
+	kept := found[:0]
+	for _, m := range found {
+		if !stops.Enclose(m) {
+			kept = append(kept, m)
+		}
+	}
+	return kept
+}
+
+func (g *guarded) Meta() Meta { return MetaOf(g.Rule) }
+
+// Labeled returns the pattern of the numbers labeled by the words, like
+// "room 1200", "version 2.0" or "#5", so they are not read as a time or a
+// date. The words are case-insensitive and may end with a dot, like "ext.",
+// the stop is the number only.
+func Labeled(words ...string) *regexp.Regexp {
+	quoted := make([]string, len(words))
+	for i, w := range words {
+		quoted[i] = regexp.QuoteMeta(w)
+	}
+	return regexp.MustCompile(`(?i)(?:[#№]|(?:^|[^\pL])(?:` + strings.Join(quoted, "|") + `)\.?\s*[#№]?)` +
+		`\s*(\d[\d.,:/-]*)`)
+}
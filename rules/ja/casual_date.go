@@ -0,0 +1,44 @@
+package ja
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"今日", "今すぐ" -> today
+	"明日", "あした" -> tomorrow
+	"明後日", "あさって" -> the day after tomorrow
+	"昨日", "きのう" -> yesterday
+	"一昨日", "おととい" -> the day before yesterday
+	"昨夜" -> 20:00 yesterday
+*/
+
+func CasualDate(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile(
+			"(今すぐ|今日|きょう|明後日|あさって|明日|あした|あす|一昨日|おととい|昨日|きのう|昨夜|昨晩)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			switch m.Captures[0] {
+			case "明後日", "あさって":
+				c.Duration = 2 * 24 * time.Hour
+			case "明日", "あした", "あす":
+				c.Duration = 24 * time.Hour
+			case "一昨日", "おととい":
+				c.Duration = -(2 * 24 * time.Hour)
+			case "昨日", "きのう":
+				c.Duration = -(24 * time.Hour)
+			case "昨夜", "昨晩":
+				c.Duration = -(24 * time.Hour)
+				c.Hour = pointer.ToInt(20)
+				c.Minute = pointer.ToInt(0)
+			}
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,78 @@
+package ja_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ja"
+)
+
+func TestCasualDate(t *testing.T) {
+	fixt := []Fixture{
+		{"締め切りは今日です", 15, "今日", 0},
+		{"締め切りは明日です", 15, "明日", 24 * time.Hour},
+		{"締め切りはあした", 15, "あした", 24 * time.Hour},
+		{"締め切りは明後日", 15, "明後日", 2 * 24 * time.Hour},
+		{"締め切りは昨日でした", 15, "昨日", -(24 * time.Hour)},
+		{"締め切りは一昨日でした", 15, "一昨日", -(2 * 24 * time.Hour)},
+		{"昨夜", 0, "昨夜", -(4 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(ja.CasualDate(rules.Skip))
+
+	ApplyFixtures(t, "ja.CasualDate", w, fixt)
+}
+
+func TestCasualTime(t *testing.T) {
+	fixt := []Fixture{
+		{"締め切りは今朝でした", 15, "今朝", 8 * time.Hour},
+		{"締め切りは午前中", 15, "午前中", 10 * time.Hour},
+		{"締め切りは午後", 15, "午後", 15 * time.Hour},
+		{"締め切りは夕方", 15, "夕方", 18 * time.Hour},
+		{"締め切りは今夜", 15, "今夜", 20 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(ja.CasualTime(rules.Skip))
+
+	ApplyFixtures(t, "ja.CasualTime", w, fixt)
+
+	fixtnil := []Fixture{
+		{"午後3時", 0, "", 0},
+		{"朝七時", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "ja.CasualTime nil", w, fixtnil)
+}
+
+func TestCasualDateCasualTime(t *testing.T) {
+	fixt := []Fixture{
+		{"明日の朝", 0, "明日の朝", (24 + 8) * time.Hour},
+		{"昨日の夜", 0, "昨日の夜", -(4 * time.Hour)},
+		{"明後日の午後", 0, "明後日の午後", (2*24 + 15) * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(
+		ja.CasualDate(rules.Skip),
+		ja.CasualTime(rules.Override),
+	)
+
+	ApplyFixtures(t, "ja.CasualDate|ja.CasualTime", w, fixt)
+}
+
+func TestMidnight(t *testing.T) {
+	fixt := []Fixture{
+		{"真夜中", 0, "真夜中", 24 * time.Hour},
+		{"今夜の真夜中", 0, "今夜の真夜中", 24 * time.Hour},
+		{"正午", 0, "正午", 12 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(ja.Midnight(rules.Override))
+
+	ApplyFixtures(t, "ja.Midnight", w, fixt)
+}
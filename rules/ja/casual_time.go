@@ -0,0 +1,68 @@
+package ja
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/cjk"
+)
+
+/*
+	"今朝", "朝" -> 8:00
+	"昼" -> 12:00
+	"午後" -> 15:00
+	"夕方" -> 18:00
+	"今夜", "夜" -> 20:00
+
+	"午前" and "午後" followed by a number are left to the hour rule.
+*/
+
+func CasualTime(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile(
+			"(今朝|朝|昼間|昼|午前中|午後|夕方|今夜|今晩|夜|晩)" +
+				"\\s*(" + cjk.INTEGER_PATTERN + ")?"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			if m.Captures[1] != "" {
+				return false, nil
+			}
+
+			switch m.Captures[0] {
+			case "今朝", "朝":
+				if o.Morning != 0 {
+					c.Hour = &o.Morning
+				} else {
+					c.Hour = pointer.ToInt(8)
+				}
+			case "午前中":
+				c.Hour = pointer.ToInt(10)
+			case "昼間", "昼":
+				if o.Noon != 0 {
+					c.Hour = &o.Noon
+				} else {
+					c.Hour = pointer.ToInt(12)
+				}
+			case "午後":
+				if o.Afternoon != 0 {
+					c.Hour = &o.Afternoon
+				} else {
+					c.Hour = pointer.ToInt(15)
+				}
+			case "夕方":
+				if o.Evening != 0 {
+					c.Hour = &o.Evening
+				} else {
+					c.Hour = pointer.ToInt(18)
+				}
+			case "今夜", "今晩", "夜", "晩":
+				c.Hour = pointer.ToInt(20)
+			}
+			c.Minute = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
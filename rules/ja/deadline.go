@@ -0,0 +1,26 @@
+package ja
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"3日後"
+	"30分後"
+	"1時間半後"
+	"数週間後"
+	"半年後"
+*/
+
+func Deadline(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile(RELATIVE_PATTERN + "\\s*(後)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			return applyRelative(m.Captures, 1, c, ref), nil
+		},
+		Strategy: s,
+	}
+}
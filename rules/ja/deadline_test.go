@@ -0,0 +1,29 @@
+package ja_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ja"
+)
+
+func TestDeadline(t *testing.T) {
+	fixt := []Fixture{
+		{"30分後", 0, "30分後", 30 * time.Minute},
+		{"三十分後", 0, "三十分後", 30 * time.Minute},
+		{"1時間半後", 0, "1時間半後", 90 * time.Minute},
+		{"3日後に連絡します", 0, "3日後", 3 * 24 * time.Hour},
+		{"2週間後", 0, "2週間後", 14 * 24 * time.Hour},
+		{"数日後", 0, "数日後", 3 * 24 * time.Hour},
+		{"1ヶ月後", 0, "1ヶ月後", 31 * 24 * time.Hour},
+		{"半年後", 0, "半年後", 182 * 24 * time.Hour},
+		{"1年後", 0, "1年後", 366 * 24 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(ja.Deadline(rules.Skip))
+
+	ApplyFixtures(t, "ja.Deadline", w, fixt)
+}
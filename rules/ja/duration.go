@@ -0,0 +1,92 @@
+package ja
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/cjk"
+)
+
+// AMOUNT_PATTERN matches an amount of the relative expressions, "数" is a
+// few and "半" is a half
+var AMOUNT_PATTERN = `(?:数|半|` + cjk.INTEGER_PATTERN + `)`
+
+var UNIT_PATTERN = `(?:秒|分|時間|日|週間|週|ヶ月|か月|カ月|ヵ月|箇月|年)`
+
+// RELATIVE_PATTERN matches an amount with its unit and an optional half,
+// like "3日" or "1時間半", capturing the three of them
+var RELATIVE_PATTERN = "(" + AMOUNT_PATTERN + ")\\s*(" + UNIT_PATTERN + ")(半)?"
+
+// parseAmount converts an amount matched by AMOUNT_PATTERN to its value
+func parseAmount(s string) (float64, bool) {
+	switch strings.TrimSpace(s) {
+	case "数":
+		return 3, true
+	case "半":
+		return 0.5, true
+	}
+	n, ok := cjk.ParseInteger(s)
+	return float64(n), ok
+}
+
+// applyRelative sets the context to the amount and unit captured by
+// RELATIVE_PATTERN, sign is negative for the past
+func applyRelative(captures []string, sign float64, c *rules.Context, ref time.Time) bool {
+	amount, ok := parseAmount(captures[0])
+	if !ok {
+		return false
+	}
+	if captures[2] != "" {
+		amount += 0.5
+	}
+	amount *= sign
+
+	var duration time.Duration
+	switch captures[1] {
+	case "秒":
+		duration = time.Second
+	case "分":
+		duration = time.Minute
+	case "時間":
+		duration = time.Hour
+	case "日":
+		duration = 24 * time.Hour
+	case "週", "週間":
+		duration = 7 * 24 * time.Hour
+	default:
+		months := amount
+		if captures[1] == "年" {
+			months *= 12
+		}
+		whole, fraction := math.Modf(months)
+		if whole != 0 {
+			shiftMonths(c, ref, int(whole))
+		}
+		if fraction != 0 {
+			c.Duration = time.Duration(fraction * float64(30*24*time.Hour))
+		}
+		return true
+	}
+
+	c.Duration = time.Duration(amount * float64(duration))
+	return true
+}
+
+// shiftMonths sets the year and month fields to the given number of months
+// from ref
+func shiftMonths(c *rules.Context, ref time.Time, months int) {
+	month := int(ref.Month()) - 1 + months
+	year := ref.Year() + month/12
+	if month %= 12; month < 0 {
+		month += 12
+		year--
+	}
+
+	if year != ref.Year() {
+		c.Year = &year
+	}
+	month++
+	c.Month = &month
+}
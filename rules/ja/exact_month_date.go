@@ -0,0 +1,43 @@
+package ja
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/cjk"
+)
+
+/*
+	"3月5日", "三月五日" -> March 5
+	"12月" -> December 1
+	"令和7年5月1日" -> May 1, 2025, with the year rule
+*/
+
+func ExactMonthDate(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile(
+			"(" + cjk.INTEGER_PATTERN + ")\\s*(月)" +
+				"(?:\\s*(" + cjk.INTEGER_PATTERN + ")\\s*(日))?"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			month, ok := cjk.ParseInteger(m.Captures[0])
+			if !ok || month < 1 || month > 12 {
+				return false, nil
+			}
+
+			day := 1
+			if m.Captures[2] != "" {
+				day, ok = cjk.ParseInteger(m.Captures[2])
+				if !ok || day < 1 || day > 31 {
+					return false, nil
+				}
+			}
+
+			c.Month = &month
+			c.Day = &day
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,33 @@
+package ja_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ja"
+)
+
+func TestExactMonthDate(t *testing.T) {
+	w := when.New(nil)
+	w.Add(ja.ExactMonthDate(rules.Override))
+
+	fixt := []Fixture{
+		{"3月5日", 0, "3月5日", 59 * 24 * time.Hour},
+		{"三月五日", 0, "三月五日", 59 * 24 * time.Hour},
+		{"12月24日", 0, "12月24日", 353 * 24 * time.Hour},
+		{"十二月二十四日", 0, "十二月二十四日", 353 * 24 * time.Hour},
+		{"２月", 0, "２月", 26 * 24 * time.Hour},
+	}
+
+	ApplyFixtures(t, "ja.ExactMonthDate", w, fixt)
+
+	fixtnil := []Fixture{
+		{"3ヶ月", 0, "", 0},
+		{"13月1日", 0, "", 0},
+		{"月曜日", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "ja.ExactMonthDate nil", w, fixtnil)
+}
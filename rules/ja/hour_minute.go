@@ -0,0 +1,81 @@
+package ja
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/cjk"
+)
+
+/*
+	"3時", "十五時" -> 3:00, 15:00
+	"午後3時" -> 15:00
+	"午前10時30分" -> 10:30
+	"7時半" -> 7:30
+	"15:30", "１５：３０" -> 15:30
+	"午前0時" -> 00:00
+
+	A number of hours like "3時間" is left to the relative rules.
+*/
+
+func HourMinute(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile(
+			"(?:(午前|午後)\\s*)?" +
+				"(?:" +
+				"(" + cjk.INTEGER_PATTERN + ")\\s*(時)(間)?" +
+				"(?:\\s*(?:(" + cjk.INTEGER_PATTERN + ")\\s*(分)|(半)))?" +
+				"|" +
+				"([0-9０-９]{1,2})\\s*[:：]\\s*([0-9０-９]{2})" +
+				")"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			if m.Captures[3] != "" {
+				return false, nil
+			}
+
+			hour, ok := cjk.ParseInteger(m.Captures[1] + m.Captures[7])
+			if !ok || hour > 24 {
+				return false, nil
+			}
+
+			minutes := 0
+			switch {
+			case m.Captures[4] != "":
+				minutes, _ = cjk.ParseInteger(m.Captures[4])
+			case m.Captures[6] != "":
+				minutes = 30
+			case m.Captures[8] != "":
+				minutes, _ = cjk.ParseInteger(m.Captures[8])
+			}
+			if minutes > 59 {
+				return false, nil
+			}
+
+			switch m.Captures[0] {
+			case "午前":
+				if hour > 12 {
+					return false, nil
+				}
+				if hour == 12 {
+					hour = 0
+				}
+			case "午後":
+				if hour > 12 {
+					return false, nil
+				}
+				if hour < 12 {
+					hour += 12
+				}
+			}
+
+			seconds := 0
+			c.Hour = &hour
+			c.Minute = &minutes
+			c.Second = &seconds
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,40 @@
+package ja_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ja"
+)
+
+func TestHourMinute(t *testing.T) {
+	fixt := []Fixture{
+		{"3時", 0, "3時", 3 * time.Hour},
+		{"十五時", 0, "十五時", 15 * time.Hour},
+		{"午後3時", 0, "午後3時", 15 * time.Hour},
+		{"午後三時半", 0, "午後三時半", 15*time.Hour + 30*time.Minute},
+		{"午前10時30分", 0, "午前10時30分", 10*time.Hour + 30*time.Minute},
+		{"7時半", 0, "7時半", 7*time.Hour + 30*time.Minute},
+		{"午前0時", 0, "午前0時", 0},
+		{"午前12時", 0, "午前12時", 0},
+		{"午後12時", 0, "午後12時", 12 * time.Hour},
+		{"15:30", 0, "15:30", 15*time.Hour + 30*time.Minute},
+		{"１５：３０", 0, "１５：３０", 15*time.Hour + 30*time.Minute},
+		{"会議は14時から", 9, "14時", 14 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(ja.HourMinute(rules.Override))
+
+	ApplyFixtures(t, "ja.HourMinute", w, fixt)
+
+	fixtnil := []Fixture{
+		{"3時間", 0, "", 0},
+		{"25時", 0, "", 0},
+		{"午後13時", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "ja.HourMinute nil", w, fixtnil)
+}
@@ -0,0 +1,85 @@
+package ja
+
+import (
+	"strings"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/cjk"
+	"github.com/olebedev/when/rules/common"
+)
+
+var describe = rules.Describer("ja")
+
+var All = []rules.Rule{
+	describe("weekday", rules.Date, 70, Weekday(rules.Override)),
+	describe("relative_week", rules.Range, 70, RelativeWeek(rules.Override)),
+	describe("casual_date", rules.Date, 60, CasualDate(rules.Override)),
+	describe("casual_time", rules.Time, 60, CasualTime(rules.Override)),
+	describe("midnight", rules.Time, 60, Midnight(rules.Override)),
+	describe("military_time", rules.Time, 50, common.MilitaryTime(rules.Override)),
+	describe("hour_minute", rules.Time, 50, HourMinute(rules.Override)),
+	describe("deadline", rules.Duration, 40, Deadline(rules.Override)),
+	describe("past_time", rules.Duration, 40, PastTime(rules.Override)),
+	describe("year", rules.Range, 30, Year(rules.Override)),
+	describe("exact_month_date", rules.Date, 30, ExactMonthDate(rules.Override)),
+}
+
+// CONNECTORS holds the words joining the parts of a time, like "の" of
+// "明日の午後3時", see rules.ClusterByTokens
+var CONNECTORS = []string{"の", "に", "で", "は"}
+
+var WEEKDAY_OFFSET = map[string]int{
+	"日": 0,
+	"月": 1,
+	"火": 2,
+	"水": 3,
+	"木": 4,
+	"金": 5,
+	"土": 6,
+}
+
+var WEEKDAY_OFFSET_PATTERN = `(?:日|月|火|水|木|金|土)`
+
+// ERA_OFFSET maps the eras to the year before their first one, so the
+// western year is the offset plus the year of the era
+var ERA_OFFSET = map[string]int{
+	"令和": 2018,
+	"平成": 1988,
+	"昭和": 1925,
+	"大正": 1911,
+	"明治": 1867,
+}
+
+// PERIOD_SHIFTS maps the relative weeks, months and years to the number of
+// them they go from the current one
+var PERIOD_SHIFTS = map[string]int{
+	"再来週": 2,
+	"来週":  1,
+	"今週":  0,
+	"先週":  -1,
+	"先々週": -2,
+	"再来月": 2,
+	"来月":  1,
+	"翌月":  1,
+	"今月":  0,
+	"先月":  -1,
+	"先々月": -2,
+	"再来年": 2,
+	"来年":  1,
+	"翌年":  1,
+	"今年":  0,
+	"去年":  -1,
+	"昨年":  -1,
+	"一昨年": -2,
+}
+
+var ERA_OFFSET_PATTERN = `(?:令和|平成|昭和|大正|明治)`
+
+// parseInteger converts an integer written with any of the numerals to its
+// value, "元" is the first year of an era
+func parseInteger(s string) (int, bool) {
+	if strings.TrimSpace(s) == "元" {
+		return 1, true
+	}
+	return cjk.ParseInteger(s)
+}
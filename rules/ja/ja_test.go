@@ -0,0 +1,57 @@
+package ja_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules/ja"
+	"github.com/stretchr/testify/require"
+)
+
+var null = time.Date(2016, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+type Fixture struct {
+	Text   string
+	Index  int
+	Phrase string
+	Diff   time.Duration
+}
+
+func ApplyFixtures(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d", name, i)
+		require.NotNil(t, res, "[%s] res #%d", name, i)
+		require.Equal(t, f.Index, res.Index, "[%s] index #%d", name, i)
+		require.Equal(t, f.Phrase, res.Text, "[%s] text #%d", name, i)
+		require.Equal(t, f.Diff, res.Time.Sub(null), "[%s] diff #%d", name, i)
+	}
+}
+
+func ApplyFixturesNil(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d", name, i)
+		require.Nil(t, res, "[%s] res #%d", name, i)
+	}
+}
+
+func TestAll(t *testing.T) {
+	w := when.New(nil)
+	w.Add(ja.All...)
+
+	// complex cases
+	fixt := []Fixture{
+		{"明日の午後3時に会議", 0, "明日の午後3時", (24 + 15) * time.Hour},
+		{"来週の月曜日の10時半", 0, "来週の月曜日の10時半", (5*24+10)*time.Hour + 30*time.Minute},
+		{"あさっての朝", 0, "あさっての朝", (2*24 + 8) * time.Hour},
+		{"3月5日の午前9時", 0, "3月5日の午前9時", (59*24 + 9) * time.Hour},
+		{"平成28年3月5日", 0, "平成28年3月5日", 59 * 24 * time.Hour},
+		{"金曜日の真夜中", 0, "金曜日の真夜中", 3 * 24 * time.Hour},
+		{"明日の正午", 0, "明日の正午", (24 + 12) * time.Hour},
+		{"3時間後", 0, "3時間後", 3 * time.Hour},
+	}
+
+	ApplyFixtures(t, "ja.All...", w, fixt)
+}
@@ -0,0 +1,43 @@
+package ja
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"真夜中" -> the end of today
+	"金曜日の真夜中" -> the end of friday, 00:00 on saturday
+	"今夜の真夜中" -> the end of today, regardless of Options.Midnight
+	"明日の正午" -> 12:00 tomorrow
+
+	A midnight belongs to the end of its day unless Options.Midnight is set
+	to rules.MidnightStartOfDay.
+*/
+
+// Midnight handles midnight and noon
+func Midnight(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile(`(今夜|今晩)?の?\s*(真夜中|夜中の12時|正午)`),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			if m.Captures[1] != "正午" {
+				c.Hour = pointer.ToInt(o.MidnightHour())
+				if m.Captures[0] != "" {
+					c.Hour = pointer.ToInt(24)
+				}
+			} else if o.Noon != 0 {
+				c.Hour = &o.Noon
+			} else {
+				c.Hour = pointer.ToInt(12)
+			}
+			c.Minute = pointer.ToInt(0)
+			c.Second = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,25 @@
+package ja
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"3日前"
+	"30分前"
+	"2週間前"
+	"1年前"
+*/
+
+func PastTime(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile(RELATIVE_PATTERN + "\\s*(前)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			return applyRelative(m.Captures, -1, c, ref), nil
+		},
+		Strategy: s,
+	}
+}
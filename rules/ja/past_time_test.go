@@ -0,0 +1,26 @@
+package ja_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ja"
+)
+
+func TestPastTime(t *testing.T) {
+	fixt := []Fixture{
+		{"30分前", 0, "30分前", -(30 * time.Minute)},
+		{"5秒前", 0, "5秒前", -(5 * time.Second)},
+		{"3日前に送りました", 0, "3日前", -(3 * 24 * time.Hour)},
+		{"2週間前", 0, "2週間前", -(14 * 24 * time.Hour)},
+		{"1ヶ月前", 0, "1ヶ月前", -(31 * 24 * time.Hour)},
+		{"一年前", 0, "一年前", -(365 * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(ja.PastTime(rules.Skip))
+
+	ApplyFixtures(t, "ja.PastTime", w, fixt)
+}
@@ -0,0 +1,41 @@
+package ja
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+)
+
+/*
+	"来週" -> 9:00 on the next Monday
+	"先週" -> -7 days
+	"来週の金曜日" -> Friday of the next week
+	"来月", "翌月" -> the first day of the next month
+	"先月" -> the previous month
+	"今年", "来年", "去年", "一昨年"
+*/
+
+func RelativeWeek(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(再来|来|先々|先|今|翌|一昨|去|昨)(週|月|年)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			shift, ok := PERIOD_SHIFTS[m.Captures[0]+m.Captures[1]]
+			if !ok {
+				return false, nil
+			}
+
+			period := common.Week
+			switch m.Captures[1] {
+			case "月":
+				period = common.Month
+			case "年":
+				period = common.Year
+			}
+
+			return common.ApplyPeriod(c, ref, period, shift), nil
+		},
+		Strategy: s,
+	}
+}
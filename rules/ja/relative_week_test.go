@@ -0,0 +1,36 @@
+package ja_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ja"
+)
+
+func TestRelativeWeek(t *testing.T) {
+	fixt := []Fixture{
+		{"来週", 0, "来週", (5*24 + 9) * time.Hour},
+		{"再来週", 0, "再来週", (12*24 + 9) * time.Hour},
+		{"先週", 0, "先週", -(7 * 24 * time.Hour)},
+		{"今週", 0, "今週", 0},
+		{"来月", 0, "来月", (26*24 + 9) * time.Hour},
+		{"先月", 0, "先月", -(31 * 24 * time.Hour)},
+		{"来年", 0, "来年", 366 * 24 * time.Hour},
+		{"去年", 0, "去年", -(365 * 24 * time.Hour)},
+		{"今年", 0, "今年", 0},
+	}
+
+	w := when.New(nil)
+	w.Add(ja.RelativeWeek(rules.Override))
+
+	ApplyFixtures(t, "ja.RelativeWeek", w, fixt)
+
+	fixtnil := []Fixture{
+		{"今日は月曜日", 0, "", 0},
+		{"先生", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "ja.RelativeWeek nil", w, fixtnil)
+}
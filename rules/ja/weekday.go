@@ -0,0 +1,58 @@
+package ja
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+/*
+	"月曜日", "次の月曜" -> the upcoming Monday
+	"今週の金曜日" -> Friday of the current week
+	"来週の月曜日" -> Monday of the next week
+	"再来週の火曜日" -> Tuesday of the week after the next one
+	"先週の金曜日" -> Friday of the last week
+
+	Weeks start on Monday.
+*/
+
+func Weekday(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile(
+			"(?:(次|再来週|来週|今週|先々週|先週)\\s*の?\\s*)?" +
+				"(" + WEEKDAY_OFFSET_PATTERN[3:] + "(曜日?)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			dayInt, ok := WEEKDAY_OFFSET[m.Captures[1]]
+			if !ok {
+				return false, nil
+			}
+			c.Weekday = pointer.ToInt(dayInt)
+
+			// count from Monday
+			from := (int(ref.Weekday()) + 6) % 7
+			diff := (dayInt+6)%7 - from
+
+			switch m.Captures[0] {
+			case "今週":
+			case "来週":
+				diff += 7
+			case "再来週":
+				diff += 14
+			case "先週":
+				diff -= 7
+			case "先々週":
+				diff -= 14
+			default:
+				if diff <= 0 {
+					diff += 7
+				}
+			}
+
+			c.Duration = time.Duration(diff) * 24 * time.Hour
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
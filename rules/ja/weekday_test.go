@@ -0,0 +1,36 @@
+package ja_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ja"
+)
+
+func TestWeekday(t *testing.T) {
+	// current is Wednesday
+	fixt := []Fixture{
+		// upcoming
+		{"月曜日", 0, "月曜日", 5 * 24 * time.Hour},
+		{"金曜", 0, "金曜", 2 * 24 * time.Hour},
+		{"水曜日", 0, "水曜日", 7 * 24 * time.Hour},
+		{"次の火曜日", 0, "次の火曜日", 6 * 24 * time.Hour},
+		// this week
+		{"今週の月曜日", 0, "今週の月曜日", -(2 * 24 * time.Hour)},
+		{"今週の日曜日", 0, "今週の日曜日", 4 * 24 * time.Hour},
+		// next weeks
+		{"来週の月曜日", 0, "来週の月曜日", 5 * 24 * time.Hour},
+		{"来週の水曜日", 0, "来週の水曜日", 7 * 24 * time.Hour},
+		{"再来週の火曜日", 0, "再来週の火曜日", 13 * 24 * time.Hour},
+		// last weeks
+		{"先週の金曜日", 0, "先週の金曜日", -(5 * 24 * time.Hour)},
+		{"先々週の月曜日", 0, "先々週の月曜日", -(16 * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(ja.Weekday(rules.Override))
+
+	ApplyFixtures(t, "ja.Weekday", w, fixt)
+}
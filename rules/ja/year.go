@@ -0,0 +1,44 @@
+package ja
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/cjk"
+	"github.com/olebedev/when/rules/common"
+)
+
+/*
+	"令和7年" -> 2025
+	"令和元年" -> 2019
+	"平成28年" -> 2016
+	"2025年", "二〇二五年" -> 2025
+*/
+
+func Year(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile(
+			"(?:(" + ERA_OFFSET_PATTERN[3:] + "\\s*(元|" + cjk.INTEGER_PATTERN + ")" +
+				"|(西暦\\s*)?([0-9０-９]{4}|[〇零一二三四五六七八九]{4}))" +
+				"\\s*(年)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			year, ok := parseInteger(m.Captures[1] + m.Captures[3])
+			if !ok {
+				return false, nil
+			}
+
+			if m.Captures[0] != "" {
+				if year == 0 {
+					return false, nil
+				}
+				year += ERA_OFFSET[m.Captures[0]]
+			}
+
+			c.Year = &year
+			common.ForgetYear(c, year)
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
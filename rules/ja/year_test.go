@@ -0,0 +1,38 @@
+package ja_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ja"
+)
+
+func TestYear(t *testing.T) {
+	w := when.New(nil)
+	w.Add(ja.Year(rules.Override))
+
+	year := func(y int) time.Duration {
+		return time.Date(y, time.January, 6, 0, 0, 0, 0, time.UTC).Sub(null)
+	}
+
+	fixt := []Fixture{
+		{"令和7年", 0, "令和7年", year(2025)},
+		{"令和元年", 0, "令和元年", year(2019)},
+		{"平成28年", 0, "平成28年", 0},
+		{"昭和六十四年", 0, "昭和六十四年", year(1989)},
+		{"2025年", 0, "2025年", year(2025)},
+		{"西暦2025年", 0, "西暦2025年", year(2025)},
+		{"二〇二五年", 0, "二〇二五年", year(2025)},
+	}
+
+	ApplyFixtures(t, "ja.Year", w, fixt)
+
+	fixtnil := []Fixture{
+		{"3年", 0, "", 0},
+		{"令和0年", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "ja.Year nil", w, fixtnil)
+}
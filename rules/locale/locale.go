@@ -0,0 +1,225 @@
+// Package locale reads the vocabulary of a language from data files and
+// builds the rules for it, so a language doesn't need any Go code. The
+// patterns of the rules are generated from the tables, see Pattern. A
+// loaded locale takes more words from another file with Data.Extend. The
+// built-in languages of the rules packages keep their tables in the same
+// JSON, their Extend adds the words and rebuilds their rules.
+//
+// The rules of a locale read the weekdays, the casual dates and parts of
+// the day, the durations and the month dates, see All. There are no rules
+// for the clock times said in words, the numeric ones like "17:30" come
+// from rules/common.
+//
+// A locale is a JSON object:
+//
+//	{
+//	  "name": "it",
+//	  "weekdays": {"domenica": 0, "lunedì": 1},
+//	  "months": {"gennaio": 1, "gen": 1},
+//	  "numerals": {"uno": 1, "due": 2},
+//	  "ordinals": {"primo": 1},
+//	  "casual_dates": {"oggi": 0, "domani": 1, "ieri": -1},
+//	  "day_parts": {"mattina": 8, "sera": 18},
+//	  "units": {"minuti": "minute", "ore": "hour", "giorni": "day"},
+//	  "next": ["prossimo"], "last": ["scorso"], "this": ["questo"],
+//	  "in": ["tra", "fra"], "ago": ["fa"], "ago_before": [],
+//	  "of": ["di"], "connectors": ["alle", "di"]
+//	}
+//
+// The units are second, minute, hour, day, week, month and year. All the
+// fields are optional, the rules without words never match.
+package locale
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/olebedev/when/rules/romance"
+	"github.com/pkg/errors"
+)
+
+// Data is the vocabulary of a language
+type Data struct {
+	Name string `json:"name"`
+
+	// Weekdays maps the weekdays to their offset from Sunday
+	Weekdays map[string]int `json:"weekdays"`
+	// Months maps the months to their number
+	Months map[string]int `json:"months"`
+	// Numerals and Ordinals map the spelled-out numbers to their value
+	Numerals map[string]int `json:"numerals"`
+	Ordinals map[string]int `json:"ordinals"`
+	// CasualDates maps the words like "tomorrow" to their offset in days
+	CasualDates map[string]int `json:"casual_dates"`
+	// DayParts maps the words like "evening" to their hour
+	DayParts map[string]int `json:"day_parts"`
+	// Units maps the words like "minutes" to their unit
+	Units map[string]string `json:"units"`
+
+	// Next, Last and This go with the weekdays, before or after them
+	Next []string `json:"next"`
+	Last []string `json:"last"`
+	This []string `json:"this"`
+
+	// In precedes an amount of time ahead, Ago follows an amount of time
+	// back, AgoBefore precedes it
+	In        []string `json:"in"`
+	Ago       []string `json:"ago"`
+	AgoBefore []string `json:"ago_before"`
+
+	// Of joins the day, the month and the year, like "de" in "5 de marzo"
+	Of []string `json:"of"`
+
+	// Connectors join the parts of a time, like "alle" in "domani alle 3",
+	// see Parser.Connect of the when package
+	Connectors []string `json:"connectors"`
+}
+
+var units = map[string]romance.Unit{
+	"second": romance.Seconds,
+	"minute": romance.Minutes,
+	"hour":   romance.Hours,
+	"day":    romance.Days,
+	"week":   romance.Weeks,
+	"month":  romance.Months,
+	"year":   romance.Years,
+}
+
+// Load reads a locale from JSON.
+func Load(r io.Reader) (*Data, error) {
+	d := &Data{}
+	if err := json.NewDecoder(r).Decode(d); err != nil {
+		return nil, errors.Wrap(err, "decode locale")
+	}
+	if err := d.normalize(); err != nil {
+		return nil, errors.Wrapf(err, "locale '%s'", d.Name)
+	}
+	return d, nil
+}
+
+// MustLoad is Load for a locale shipped with the program, like the built-in
+// languages of the rules packages, it panics if the locale is broken.
+func MustLoad(b []byte) *Data {
+	d, err := Load(bytes.NewReader(b))
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// LoadFile reads a locale from a JSON file.
+func LoadFile(path string) (*Data, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open locale")
+	}
+	defer f.Close()
+
+	return Load(f)
+}
+
+// Extend adds the words of ext to the locale, ext wins where both have a
+// word. The name is kept unless the locale has none.
+func (d *Data) Extend(ext *Data) {
+	if d.Name == "" {
+		d.Name = ext.Name
+	}
+
+	d.Weekdays = merge(d.Weekdays, ext.Weekdays)
+	d.Months = merge(d.Months, ext.Months)
+	d.Numerals = merge(d.Numerals, ext.Numerals)
+	d.Ordinals = merge(d.Ordinals, ext.Ordinals)
+	d.CasualDates = merge(d.CasualDates, ext.CasualDates)
+	d.DayParts = merge(d.DayParts, ext.DayParts)
+
+	if d.Units == nil {
+		d.Units = make(map[string]string, len(ext.Units))
+	}
+	for k, v := range ext.Units {
+		d.Units[k] = v
+	}
+
+	d.Next = append(d.Next, ext.Next...)
+	d.Last = append(d.Last, ext.Last...)
+	d.This = append(d.This, ext.This...)
+	d.In = append(d.In, ext.In...)
+	d.Ago = append(d.Ago, ext.Ago...)
+	d.AgoBefore = append(d.AgoBefore, ext.AgoBefore...)
+	d.Of = append(d.Of, ext.Of...)
+	d.Connectors = append(d.Connectors, ext.Connectors...)
+}
+
+// normalize lower-cases the words and checks the units
+func (d *Data) normalize() error {
+	for _, m := range []*map[string]int{&d.Weekdays, &d.Months, &d.Numerals, &d.Ordinals, &d.CasualDates, &d.DayParts} {
+		*m = merge(nil, *m)
+	}
+
+	lower := make(map[string]string, len(d.Units))
+	for k, v := range d.Units {
+		if _, ok := units[v]; !ok {
+			return errors.Errorf("unknown unit '%s' of '%s'", v, k)
+		}
+		lower[strings.ToLower(k)] = v
+	}
+	d.Units = lower
+
+	return nil
+}
+
+func merge(dst, src map[string]int) map[string]int {
+	if dst == nil {
+		dst = make(map[string]int, len(src))
+	}
+	for k, v := range src {
+		dst[strings.ToLower(k)] = v
+	}
+	return dst
+}
+
+// Pattern returns a non-capturing group matching the words of the table,
+// like "(?:monday|mon)". The longer words go first so "mon" doesn't cut
+// "monday" short, the spaces inside the words match any spaces. A table
+// without words gives a group that never matches.
+func Pattern(table map[string]int) string {
+	words := make([]string, 0, len(table))
+	for w := range table {
+		words = append(words, w)
+	}
+	return ListPattern(words)
+}
+
+// ListPattern is Pattern for a list of words.
+func ListPattern(words []string) string {
+	if len(words) == 0 {
+		return `(?:\b\B)`
+	}
+
+	sorted := append([]string(nil), words...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if len(sorted[i]) != len(sorted[j]) {
+			return len(sorted[i]) > len(sorted[j])
+		}
+		return sorted[i] < sorted[j]
+	})
+
+	quoted := make([]string, 0, len(sorted))
+	for i, w := range sorted {
+		if i > 0 && w == sorted[i-1] {
+			continue
+		}
+		quoted = append(quoted, strings.Join(strings.Fields(regexp.QuoteMeta(w)), `\s+`))
+	}
+	return "(?:" + strings.Join(quoted, "|") + ")"
+}
+
+// lookup finds a word in the table, whatever its case and spaces
+func lookup(table map[string]int, word string) (int, bool) {
+	n, ok := table[strings.ToLower(strings.Join(strings.Fields(word), " "))]
+	return n, ok
+}
@@ -0,0 +1,146 @@
+package locale_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules/en"
+	"github.com/olebedev/when/rules/locale"
+	"github.com/stretchr/testify/require"
+)
+
+var null = time.Date(2016, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+type Fixture struct {
+	Text   string
+	Index  int
+	Phrase string
+	Diff   time.Duration
+}
+
+func ApplyFixtures(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d", name, i)
+		require.NotNil(t, res, "[%s] res #%d", name, i)
+		require.Equal(t, f.Index, res.Index, "[%s] index #%d", name, i)
+		require.Equal(t, f.Phrase, res.Text, "[%s] text #%d", name, i)
+		require.Equal(t, f.Diff, res.Time.Sub(null), "[%s] diff #%d", name, i)
+	}
+}
+
+func ApplyFixturesNil(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d", name, i)
+		require.Nil(t, res, "[%s] res #%d", name, i)
+	}
+}
+
+func TestPattern(t *testing.T) {
+	require.Equal(t, `(?:monday|mon)`, locale.Pattern(map[string]int{"mon": 1, "monday": 1}))
+	require.Equal(t, `(?:sept\.|sept|sep)`, locale.Pattern(map[string]int{"sep": 9, "sept": 9, "sept.": 9}))
+	require.Equal(t, `(?:twenty\s+first|21st)`, locale.Pattern(map[string]int{"21st": 21, "twenty first": 21}))
+	require.Equal(t, `(?:\b\B)`, locale.Pattern(nil))
+}
+
+func TestLoad(t *testing.T) {
+	d, err := locale.LoadFile("testdata/it.json")
+	require.Nil(t, err)
+	require.Equal(t, "it", d.Name)
+	require.Equal(t, 3, d.Months["marzo"])
+	require.Equal(t, "hour", d.Units["ore"])
+	require.Equal(t, []string{"alle", "di"}, d.Connectors)
+
+	_, err = locale.Load(strings.NewReader(`{"units": {"ore": "hours"}}`))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "unknown unit 'hours'")
+
+	_, err = locale.Load(strings.NewReader(`{"months": []}`))
+	require.NotNil(t, err)
+
+	_, err = locale.LoadFile("testdata/missing.json")
+	require.NotNil(t, err)
+}
+
+func TestAll(t *testing.T) {
+	d, err := locale.LoadFile("testdata/it.json")
+	require.Nil(t, err)
+
+	w := when.New(nil)
+	w.Add(locale.All(d)...)
+
+	fixt := []Fixture{
+		{"lunedì", 0, "lunedì", 5 * 24 * time.Hour},
+		{"ci vediamo venerdì prossimo", 11, "venerdì prossimo", 2 * 24 * time.Hour},
+		{"sabato scorso", 0, "sabato scorso", -4 * 24 * time.Hour},
+		{"domani sera", 0, "domani sera", (24 + 18) * time.Hour},
+		{"l'altro ieri", 0, "l'altro ieri", -2 * 24 * time.Hour},
+		{"nel pomeriggio", 4, "pomeriggio", 15 * time.Hour},
+		{"tra 3 giorni", 0, "tra 3 giorni", 3 * 24 * time.Hour},
+		{"fra due ore", 0, "fra due ore", 2 * time.Hour},
+		{"5 minuti fa", 0, "5 minuti fa", -5 * time.Minute},
+		{"un mese fa", 0, "un mese fa", -31 * 24 * time.Hour},
+		{"5 di marzo", 0, "5 di marzo", (31 + 29 + 4 - 5) * 24 * time.Hour},
+		{"primo febbraio 2017", 0, "primo febbraio 2017", (366 + 26) * 24 * time.Hour},
+	}
+
+	ApplyFixtures(t, "locale.All", w, fixt)
+
+	fixtnil := []Fixture{
+		{"la lunetta", 0, "", 0},
+		{"tra poco", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "locale.All nil", w, fixtnil)
+}
+
+func TestExtend(t *testing.T) {
+	d, err := locale.LoadFile("testdata/it.json")
+	require.Nil(t, err)
+	ext, err := locale.LoadFile("testdata/it_ext.json")
+	require.Nil(t, err)
+	d.Extend(ext)
+	require.Equal(t, []string{"alle", "di", "verso"}, d.Connectors)
+
+	w := when.New(nil)
+	w.Add(locale.All(d)...)
+
+	fixt := []Fixture{
+		{"stamattina", 0, "stamattina", 0},
+		{"tra 2 h", 0, "tra 2 h", 2 * time.Hour},
+		{"da 3 giorni", 0, "da 3 giorni", -3 * 24 * time.Hour},
+		{"3 giorni fa", 0, "3 giorni fa", -3 * 24 * time.Hour},
+	}
+
+	ApplyFixtures(t, "locale.Extend", w, fixt)
+}
+
+func TestExtendBuiltin(t *testing.T) {
+	before := when.New(nil)
+	before.Add(en.All...)
+
+	en.Extend(&locale.Data{
+		Weekdays: map[string]int{"Tues": 2, "thurs": 4},
+		Ordinals: map[string]int{"twenty first": 21},
+	})
+	require.Equal(t, 2, en.WEEKDAY_OFFSET["tues"])
+
+	w := when.New(nil)
+	w.Add(en.All...)
+
+	fixt := []Fixture{
+		{"see you tues", 8, "tues", (6*24 + 9) * time.Hour},
+		{"next thurs", 0, "next thurs", (24 + 9) * time.Hour},
+		{"the twenty first of march", 4, "twenty first of march", (31 + 29 + 20 - 5) * 24 * time.Hour},
+		{"monday", 0, "monday", (5*24 + 9) * time.Hour},
+	}
+
+	ApplyFixtures(t, "en.Extend", w, fixt)
+
+	ApplyFixturesNil(t, "en.Extend before", before, []Fixture{
+		{"see you tues", 0, "", 0},
+	})
+}
@@ -0,0 +1,160 @@
+package locale
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+)
+
+// All returns the rules of the locale, named after it.
+func All(d *Data) []rules.Rule {
+	describe := rules.Describer(d.Name)
+	return []rules.Rule{
+		describe("weekday", rules.Date, 70, Weekday(rules.Override, d)),
+		describe("casual_date", rules.Date, 60, CasualDate(rules.Override, d)),
+		describe("casual_time", rules.Time, 60, CasualTime(rules.Override, d)),
+		describe("deadline", rules.Duration, 40, Deadline(rules.Override, d)),
+		describe("past_time", rules.Duration, 40, PastTime(rules.Override, d)),
+		describe("month_date", rules.Date, 30, MonthDate(rules.Override, d)),
+	}
+}
+
+/*
+	"lunedì" -> the upcoming Monday
+	"prossimo lunedì", "lunedì prossimo" -> the upcoming Monday
+	"scorso venerdì" -> the past Friday
+	"questo venerdì" -> Friday of the current week
+*/
+
+func Weekday(s rules.Strategy, d *Data) rules.Rule {
+	return romance.Weekday(s, &romance.WeekdayWords{
+		Offsets:   d.Weekdays,
+		Pattern:   Pattern(d.Weekdays),
+		Articles:  ListPattern(nil),
+		Next:      ListPattern(d.Next),
+		Last:      ListPattern(d.Last),
+		This:      ListPattern(d.This),
+		NextAfter: ListPattern(d.Next),
+		LastAfter: ListPattern(d.Last),
+		WeekStart: time.Monday,
+	})
+}
+
+/*
+	"oggi", "domani", "ieri"
+	"domani sera" -> 18:00 tomorrow
+*/
+
+func CasualDate(s rules.Strategy, d *Data) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"(" + Pattern(d.CasualDates) + ")" +
+			"(?:\\s+(" + Pattern(d.DayParts) + "))?" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			days, ok := lookup(d.CasualDates, m.Captures[0])
+			if !ok {
+				return false, nil
+			}
+
+			c.Duration = time.Duration(days) * 24 * time.Hour
+
+			if hour, ok := lookup(d.DayParts, m.Captures[1]); ok {
+				c.Hour = &hour
+				c.Minute = pointer.ToInt(0)
+			}
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
+
+/*
+	"sera" -> 18:00
+*/
+
+func CasualTime(s rules.Strategy, d *Data) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"(" + Pattern(d.DayParts) + ")" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			hour, ok := lookup(d.DayParts, m.Captures[0])
+			if !ok {
+				return false, nil
+			}
+
+			c.Hour = &hour
+			c.Minute = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
+
+/*
+	"tra 3 giorni", "fra due ore"
+*/
+
+func Deadline(s rules.Strategy, d *Data) rules.Rule {
+	return romance.Deadline(s, ListPattern(d.In), relative(d))
+}
+
+/*
+	"3 giorni fa", "due ore fa"
+*/
+
+func PastTime(s rules.Strategy, d *Data) rules.Rule {
+	prefix, suffix := "", ""
+	if len(d.AgoBefore) > 0 {
+		prefix = ListPattern(d.AgoBefore)
+	}
+	if len(d.Ago) > 0 {
+		suffix = ListPattern(d.Ago)
+	}
+	return romance.PastTime(s, prefix, suffix, relative(d))
+}
+
+/*
+	"5 marzo", "primo marzo 2017", "5 di marzo"
+*/
+
+func MonthDate(s rules.Strategy, d *Data) rules.Rule {
+	of := ""
+	if len(d.Of) > 0 {
+		of = ListPattern(d.Of)
+	}
+	return romance.ExactMonthDate(s, &romance.MonthWords{
+		Offsets:         d.Months,
+		Pattern:         Pattern(d.Months),
+		Ordinals:        d.Ordinals,
+		OrdinalsPattern: Pattern(d.Ordinals),
+		Of:              of,
+	})
+}
+
+// relative returns the words of the relative expressions of the locale
+func relative(d *Data) *romance.Relative {
+	r := &romance.Relative{
+		Amounts:        make(map[string]float64, len(d.Numerals)),
+		AmountsPattern: Pattern(d.Numerals),
+		Units:          make(map[string]romance.Unit, len(d.Units)),
+	}
+	for w, n := range d.Numerals {
+		r.Amounts[w] = float64(n)
+	}
+
+	words := make([]string, 0, len(d.Units))
+	for w, u := range d.Units {
+		r.Units[w] = units[u]
+		words = append(words, w)
+	}
+	r.UnitsPattern = ListPattern(words)
+
+	return r
+}
@@ -0,0 +1,70 @@
+package rules
+
+import "time"
+
+// MeridiemPolicy tells how the rules read an hour of a 12-hour clock said
+// without am or pm, like "at 7"
+type MeridiemPolicy int
+
+const (
+	// MeridiemAsIs reads the hour as it is said: "7:30" is 07:30 and a
+	// bare "at 7" is no time
+	MeridiemAsIs MeridiemPolicy = iota
+	// MeridiemInfer picks am or pm by the words around the hour, the
+	// working hours and the reference time: "lunch at 1" is 13:00
+	MeridiemInfer
+)
+
+// Meridiem is the half of the day the words around an hour point to
+type Meridiem int
+
+const (
+	// NoMeridiem is for the words pointing to no half of the day
+	NoMeridiem Meridiem = iota
+	// AM is for the words like "morning"
+	AM
+	// PM is for the words like "evening" or "tonight"
+	PM
+)
+
+// WorkingHours returns the first and the last hour of the working hours,
+// 8 and 18 unless Options.WorkStart and Options.WorkEnd are set
+func (o *Options) WorkingHours() (int, int) {
+	if o == nil || o.WorkStart == 0 && o.WorkEnd == 0 {
+		return 8, 18
+	}
+	return o.WorkStart, o.WorkEnd
+}
+
+// InferHour returns the hour of a day for the hour of a 12-hour clock said
+// without am or pm and tells if it was inferred. With MeridiemInfer the
+// words around the hour go first, then the half of the day putting the
+// hour within the working hours, then the half coming next after the
+// reference time. The hours out of 1 to 11 are returned as they are.
+func (o *Options) InferHour(hour int, hint Meridiem, ref time.Time) (int, bool) {
+	if o == nil || o.Meridiem != MeridiemInfer || hour < 1 || hour > 11 {
+		return hour, false
+	}
+
+	am, pm := hour, hour+12
+	switch hint {
+	case AM:
+		return am, true
+	case PM:
+		return pm, true
+	}
+
+	start, end := o.WorkingHours()
+	work := func(h int) bool { return h >= start && h < end }
+	switch {
+	case work(am) && !work(pm):
+		return am, true
+	case work(pm) && !work(am):
+		return pm, true
+	}
+
+	if now := ref.Hour(); now >= am && now < pm {
+		return pm, true
+	}
+	return am, true
+}
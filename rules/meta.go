@@ -0,0 +1,87 @@
+package rules
+
+// Category is the kind of expressions a rule matches
+type Category int
+
+const (
+	// Uncategorized is the category of the rules with no metadata
+	Uncategorized Category = iota
+	// Date rules match a day: "next friday", "march 5th", "tomorrow"
+	Date
+	// Time rules match a time of the day: "5pm", "noon", "half past 2"
+	Time
+	// Duration rules match a time relative to now: "in 5 minutes", "2
+	// days ago"
+	Duration
+	// Range rules match a period: "this weekend", "next week", "next
+	// summer"
+	Range
+)
+
+func (c Category) String() string {
+	switch c {
+	case Date:
+		return "date"
+	case Time:
+		return "time"
+	case Duration:
+		return "duration"
+	case Range:
+		return "range"
+	}
+	return "uncategorized"
+}
+
+// Meta describes a rule
+type Meta struct {
+	// Name is a stable name of the rule, prefixed with its locale, like
+	// "en.weekday"
+	Name string
+	// Locale is the name of the language of the rule, like "en"
+	Locale   string
+	Category Category
+	// Priority orders the rules of a parser, the higher ones go first and
+	// the ones of the same priority keep the order they were added in
+	Priority int
+}
+
+// Described is a rule knowing its metadata
+type Described interface {
+	Rule
+	Meta() Meta
+}
+
+// Describe returns the rule along with the metadata
+func Describe(r Rule, m Meta) Described {
+	return &described{Rule: r, meta: m}
+}
+
+type described struct {
+	Rule
+	meta Meta
+}
+
+func (d *described) Meta() Meta { return d.meta }
+
+func (d *described) FindAll(text string) []*Match { return FindAll(d.Rule, text) }
+
+// Describer returns a function describing the rules of the locale, the
+// names of the rules are prefixed with it
+func Describer(locale string) func(name string, c Category, priority int, r Rule) Rule {
+	return func(name string, c Category, priority int, r Rule) Rule {
+		return Describe(r, Meta{
+			Name:     locale + "." + name,
+			Locale:   locale,
+			Category: c,
+			Priority: priority,
+		})
+	}
+}
+
+// MetaOf returns the metadata of the rule, the zero Meta if it has none
+func MetaOf(r Rule) Meta {
+	if d, ok := r.(Described); ok {
+		return d.Meta()
+	}
+	return Meta{}
+}
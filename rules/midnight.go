@@ -0,0 +1,20 @@
+package rules
+
+type MidnightConvention int
+
+const (
+	// MidnightEndOfDay resolves "midnight friday" to 00:00 on Saturday
+	MidnightEndOfDay MidnightConvention = iota
+	// MidnightStartOfDay resolves "midnight friday" to 00:00 on Friday
+	MidnightStartOfDay
+)
+
+// MidnightHour returns the hour a midnight resolves to within its day: 24
+// for the end of the day, which normalises to 00:00 on the next one, or 0
+// for the start of the day.
+func (o *Options) MidnightHour() int {
+	if o != nil && o.Midnight == MidnightStartOfDay {
+		return 0
+	}
+	return 24
+}
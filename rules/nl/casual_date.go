@@ -10,8 +10,6 @@ import (
 )
 
 func CasualDate(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)(?:\\W|^)(nu|vandaag|vanavond|vannacht|afgelopen\\s*nacht|morgen|gister|gisteren)(ochtend|morgen|middag|avond)?(?:\\W|$)"),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
@@ -42,28 +40,21 @@ func CasualDate(s rules.Strategy) rules.Rule {
 
 			switch {
 			case strings.Contains(lower, "vannacht"):
-				if c.Hour == nil && c.Minute == nil || overwrite {
-					c.Hour = pointer.ToInt(23)
-					c.Minute = pointer.ToInt(0)
-				}
+				c.Hour = pointer.ToInt(23)
+				c.Minute = pointer.ToInt(0)
 			case strings.Contains(lower, "vandaag"):
 				// c.Hour = pointer.ToInt(18)
 			case strings.Contains(lower, "morgen"):
-				if c.Duration == 0 || overwrite {
-					c.Duration += time.Hour * 24
-				}
+				c.Duration += time.Hour * 24
 			case strings.Contains(lower, "gister"):
-				if c.Duration == 0 || overwrite {
-					c.Duration -= time.Hour * 24
-				}
+				c.Duration -= time.Hour * 24
 			case strings.Contains(lower, "afgelopen nacht"):
-				if (c.Hour == nil && c.Duration == 0) || overwrite {
-					c.Hour = pointer.ToInt(23)
-					c.Duration -= time.Hour * 24
-				}
+				c.Hour = pointer.ToInt(23)
+				c.Duration -= time.Hour * 24
 			}
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
@@ -0,0 +1,54 @@
+package nl
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+)
+
+/*
+	"5 maart 2017"
+	"derde van mei 2018"
+	"1e januari 2020"
+*/
+
+func DateWithYear(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
+			"(?:(" + ORDINAL_WORDS_PATTERN[3:] + "|([0-9]{1,2}))" +
+			"\\s+(?:van\\s+)?(" + MONTH_OFFSET_PATTERN[3:] +
+			"\\s+([0-9]{4})" +
+			"(?:\\W|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			day, ok := ORDINAL_WORDS[strings.ToLower(m.Captures[0])]
+			if !ok {
+				day, _ = strconv.Atoi(m.Captures[1])
+			}
+			if day < 1 || day > 31 {
+				return false, nil
+			}
+
+			month, ok := MONTH_OFFSET[strings.ToLower(m.Captures[2])]
+			if !ok {
+				return false, nil
+			}
+
+			year, err := strconv.Atoi(m.Captures[3])
+			if err != nil {
+				return false, nil
+			}
+
+			c.Year = &year
+			c.Month = &month
+			c.Day = &day
+			common.ForgetYear(c, year)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
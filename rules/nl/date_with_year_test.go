@@ -0,0 +1,31 @@
+package nl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/nl"
+)
+
+func TestDateWithYear(t *testing.T) {
+	fixt := []Fixture{
+		{"5 maart 2017", 0, "5 maart 2017", time.Date(2017, 3, 5, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"op derde van mei 2018", 3, "derde van mei 2018", time.Date(2018, 5, 3, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"1e februari 2020", 0, "1e februari 2020", time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"24 dec 2015", 0, "24 dec 2015", -(13 * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(nl.DateWithYear(rules.Override))
+
+	ApplyFixtures(t, "nl.DateWithYear", w, fixt)
+
+	fixtnil := []Fixture{
+		{"5 maart", 0, "", 0},
+		{"45 maart 2017", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "nl.DateWithYear nil", w, fixtnil)
+}
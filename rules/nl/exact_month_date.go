@@ -28,8 +28,6 @@ import (
 // 5. - ordinal day?
 
 func ExactMonthDate(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)" +
 			"(?:\\W|^)" +
@@ -40,8 +38,6 @@ func ExactMonthDate(s rules.Strategy) rules.Rule {
 		),
 
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			_ = overwrite
-
 			ord1 := strings.ToLower(strings.TrimSpace(m.Captures[0]))
 			num1 := strings.ToLower(strings.TrimSpace(m.Captures[1]))
 			mon := strings.ToLower(strings.TrimSpace(m.Captures[2]))
@@ -97,5 +93,6 @@ func ExactMonthDate(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
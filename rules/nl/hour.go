@@ -31,10 +31,6 @@ func Hour(s rules.Strategy) rules.Rule {
 			"(?:\\s*((in de|\\'s) (middags?|avonds?))?)" +
 			"(?:\\W|$)"),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if c.Hour != nil && s != rules.Override {
-				return false, nil
-			}
-
 			lower := strings.ToLower(strings.TrimSpace(m.String()))
 			hour, err := strconv.Atoi(m.Captures[2])
 
@@ -49,6 +45,12 @@ func Hour(s rules.Strategy) rules.Rule {
 			}
 			c.Hour = &hour
 
+			// 12am is the start of the day
+			if hour == 12 && strings.HasPrefix(strings.ToLower(strings.TrimSpace(m.Captures[3])), "a") {
+				hour = 0
+				c.Hour = &hour
+			}
+
 			// pm
 			if regexp.MustCompile("p.?(m.?)?").MatchString(strings.ToLower(strings.TrimSpace(m.Captures[3]))) {
 				if hour < 12 {
@@ -68,5 +70,6 @@ func Hour(s rules.Strategy) rules.Rule {
 			c.Second = &zero
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
@@ -36,10 +36,6 @@ func HourMinute(s rules.Strategy) rules.Rule {
 			"(?:\\s*((in de|\\'s) (middags?|avonds?))?)" +
 			"(?:\\W|$)"),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if (c.Hour != nil || c.Minute != nil) && s != rules.Override {
-				return false, nil
-			}
-
 			lower := strings.ToLower(strings.TrimSpace(m.String()))
 			hour, err := strconv.Atoi(m.Captures[2])
 			if err != nil {
@@ -61,6 +57,12 @@ func HourMinute(s rules.Strategy) rules.Rule {
 			}
 			c.Hour = &hour
 
+			// 12am is the start of the day
+			if hour == 12 && strings.HasPrefix(strings.ToLower(strings.TrimSpace(m.Captures[4])), "a") {
+				hour = 0
+				c.Hour = &hour
+			}
+
 			// pm
 			if regexp.MustCompile("p.?(m.?)?").MatchString(strings.ToLower(strings.TrimSpace(m.Captures[4]))) {
 				if hour < 12 {
@@ -81,5 +83,6 @@ func HourMinute(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
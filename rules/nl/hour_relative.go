@@ -0,0 +1,95 @@
+package nl
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+)
+
+/*
+	"kwart over acht" -> 8:15
+	"kwart voor negen" -> 8:45
+	"half negen" -> 8:30
+	"tien voor half negen" -> 8:20
+	"vijf over half zeven 's avonds" -> 18:35
+
+	The half goes towards the next hour, "half een" is 12:30.
+*/
+
+func HourRelative(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
+			"(?:(kwart|" + INTEGER_WORDS_PATTERN + "|[0-9]{1,2})(?:\\s+minuten?)?\\s+(over|voor)\\s+)?" +
+			"(half\\s+)?" +
+			"(" + INTEGER_WORDS_PATTERN + "|[0-9]{1,2})" +
+			"(?:\\s+(?:'s\\s+|in\\s+de\\s+)(ochtends?|morgens?|middags?|avonds?|nachts?))?" +
+			"(?:\\W|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			if m.Captures[1] == "" && m.Captures[2] == "" {
+				return false, nil
+			}
+
+			hour, ok := parseNumber(m.Captures[3])
+			if !ok || hour > 12 {
+				return false, nil
+			}
+
+			total := hour * 60
+			if m.Captures[2] != "" {
+				total -= 30
+			}
+
+			if m.Captures[1] != "" {
+				minutes, ok := parseNumber(m.Captures[0])
+				if !ok || minutes >= 30 {
+					return false, nil
+				}
+				if strings.ToLower(m.Captures[1]) == "over" {
+					total += minutes
+				} else {
+					total -= minutes
+				}
+			}
+
+			if total < 60 {
+				total += 12 * 60
+			}
+
+			hour, minute := total/60%24, total%60
+
+			switch part := strings.ToLower(m.Captures[4]); {
+			case strings.HasPrefix(part, "middag"), strings.HasPrefix(part, "avond"):
+				if hour < 12 {
+					hour += 12
+				}
+			case strings.HasPrefix(part, "nacht"):
+				hour = common.Night.Clock(hour, o)
+			}
+
+			zero := 0
+			c.Hour = &hour
+			c.Minute = &minute
+			c.Second = &zero
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
+
+// parseNumber converts a digit, spelled-out integer or "kwart" to its value
+func parseNumber(s string) (int, bool) {
+	s = strings.ToLower(s)
+	if s == "kwart" {
+		return 15, true
+	}
+	if n, ok := INTEGER_WORDS[s]; ok {
+		return n, true
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil
+}
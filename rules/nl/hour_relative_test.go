@@ -0,0 +1,35 @@
+package nl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/nl"
+)
+
+func TestHourRelative(t *testing.T) {
+	fixt := []Fixture{
+		{"kwart over acht", 0, "kwart over acht", 8*time.Hour + 15*time.Minute},
+		{"om kwart voor negen", 3, "kwart voor negen", 8*time.Hour + 45*time.Minute},
+		{"half negen", 0, "half negen", 8*time.Hour + 30*time.Minute},
+		{"tien voor half negen", 0, "tien voor half negen", 8*time.Hour + 20*time.Minute},
+		{"vijf over half zeven 's avonds", 0, "vijf over half zeven 's avonds", 18*time.Hour + 35*time.Minute},
+		{"half een", 0, "half een", 12*time.Hour + 30*time.Minute},
+		{"10 minuten over 3 in de middag", 0, "10 minuten over 3 in de middag", 15*time.Hour + 10*time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Add(nl.HourRelative(rules.Override))
+
+	ApplyFixtures(t, "nl.HourRelative", w, fixt)
+
+	fixtnil := []Fixture{
+		{"acht", 0, "", 0},
+		{"een half uur", 0, "", 0},
+		{"veertig over acht", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "nl.HourRelative nil", w, fixtnil)
+}
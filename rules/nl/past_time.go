@@ -12,8 +12,6 @@ import (
 )
 
 func PastTime(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile(
 			"(?i)(?:\\W|^)\\s*" +
@@ -47,61 +45,38 @@ func PastTime(s rules.Strategy) rules.Rule {
 			if !strings.Contains(numStr, "half") && !strings.Contains(numStr, "halve") {
 				switch {
 				case strings.Contains(exponent, "seconde"):
-					if c.Duration == 0 || overwrite {
-						c.Duration = -(time.Duration(num) * time.Second)
-					}
+					c.Duration = -(time.Duration(num) * time.Second)
 				case strings.Contains(exponent, "min"):
-					if c.Duration == 0 || overwrite {
-						c.Duration = -(time.Duration(num) * time.Minute)
-					}
+					c.Duration = -(time.Duration(num) * time.Minute)
 				case strings.Contains(exponent, "uur"), strings.Contains(exponent, "uren"):
-					if c.Duration == 0 || overwrite {
-						c.Duration = -(time.Duration(num) * time.Hour)
-					}
+					c.Duration = -(time.Duration(num) * time.Hour)
 				case strings.Contains(exponent, "dag"):
-					if c.Duration == 0 || overwrite {
-						c.Duration = -(time.Duration(num) * 24 * time.Hour)
-					}
+					c.Duration = -(time.Duration(num) * 24 * time.Hour)
 				case strings.Contains(exponent, "week"), strings.Contains(exponent, "weken"):
-					if c.Duration == 0 || overwrite {
-						c.Duration = -(time.Duration(num) * 7 * 24 * time.Hour)
-					}
+					c.Duration = -(time.Duration(num) * 7 * 24 * time.Hour)
 				case strings.Contains(exponent, "maand"):
-					if c.Month == nil || overwrite {
-						c.Month = pointer.ToInt((int(ref.Month()) - num) % 12)
-					}
+					c.Month = pointer.ToInt((int(ref.Month()) - num) % 12)
 				case strings.Contains(exponent, "jaar"):
-					if c.Year == nil || overwrite {
-						c.Year = pointer.ToInt(ref.Year() - num)
-					}
+					c.Year = pointer.ToInt(ref.Year() - num)
 				}
 			} else {
 				switch {
 				case strings.Contains(exponent, "uur"), strings.Contains(exponent, "uren"):
-					if c.Duration == 0 || overwrite {
-						c.Duration = -(30 * time.Minute)
-					}
+					c.Duration = -(30 * time.Minute)
 				case strings.Contains(exponent, "dag"):
-					if c.Duration == 0 || overwrite {
-						c.Duration = -(12 * time.Hour)
-					}
+					c.Duration = -(12 * time.Hour)
 				case strings.Contains(exponent, "week"):
-					if c.Duration == 0 || overwrite {
-						c.Duration = -(7 * 12 * time.Hour)
-					}
+					c.Duration = -(7 * 12 * time.Hour)
 				case strings.Contains(exponent, "maand"):
-					if c.Duration == 0 || overwrite {
-						// 2 weeks
-						c.Duration = -(14 * 24 * time.Hour)
-					}
+					// 2 weeks
+					c.Duration = -(14 * 24 * time.Hour)
 				case strings.Contains(exponent, "jaar"):
-					if c.Month == nil || overwrite {
-						c.Month = pointer.ToInt((int(ref.Month()) - 6) % 12)
-					}
+					c.Month = pointer.ToInt((int(ref.Month()) - 6) % 12)
 				}
 			}
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
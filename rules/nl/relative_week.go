@@ -0,0 +1,47 @@
+package nl
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+)
+
+/*
+	"volgende week", "komende week" -> 9:00 on the next Monday
+	"vorige week", "afgelopen week" -> -7 days
+	"volgende maand" -> the first day of the next month
+	"vorig jaar" -> the previous year
+	"deze week", "dit jaar" -> the current week or year
+	"volgende week vrijdag" -> Friday of the next week
+*/
+
+func RelativeWeek(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
+			"(volgende?|komende?|vorige?|afgelopen|deze|dit)\\s+(week|maand|jaar)" +
+			"(?:\\W|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			shift := 0
+			switch direction := strings.ToLower(m.Captures[0]); {
+			case strings.HasPrefix(direction, "volgend"), strings.HasPrefix(direction, "komend"):
+				shift = 1
+			case strings.HasPrefix(direction, "vorig"), direction == "afgelopen":
+				shift = -1
+			}
+
+			period := common.Week
+			switch strings.ToLower(m.Captures[1]) {
+			case "maand":
+				period = common.Month
+			case "jaar":
+				period = common.Year
+			}
+
+			return common.ApplyPeriod(c, ref, period, shift), nil
+		},
+		Strategy: s,
+	}
+}
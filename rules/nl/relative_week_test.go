@@ -0,0 +1,27 @@
+package nl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/nl"
+)
+
+func TestRelativeWeek(t *testing.T) {
+	fixt := []Fixture{
+		{"volgende week", 0, "volgende week", (5*24 + 9) * time.Hour},
+		{"dat was vorige week", 8, "vorige week", -(7 * 24 * time.Hour)},
+		{"deze week", 0, "deze week", 0},
+		{"komende maand", 0, "komende maand", (26*24 + 9) * time.Hour},
+		{"afgelopen maand", 0, "afgelopen maand", -(31 * 24 * time.Hour)},
+		{"volgend jaar", 0, "volgend jaar", 366 * 24 * time.Hour},
+		{"dit jaar", 0, "dit jaar", 0},
+	}
+
+	w := when.New(nil)
+	w.Add(nl.RelativeWeek(rules.Override))
+
+	ApplyFixtures(t, "nl.RelativeWeek", w, fixt)
+}
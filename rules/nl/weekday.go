@@ -5,12 +5,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/AlekSi/pointer"
 	"github.com/olebedev/when/rules"
 )
 
 func Weekday(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)" +
 			"(?:\\W|^)" +
@@ -22,8 +21,6 @@ func Weekday(s rules.Strategy) rules.Rule {
 		),
 
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			_ = overwrite
-
 			day := strings.ToLower(strings.TrimSpace(m.Captures[1]))
 			norm := strings.ToLower(strings.TrimSpace(m.Captures[0] + m.Captures[2]))
 			if norm == "" {
@@ -33,10 +30,7 @@ func Weekday(s rules.Strategy) rules.Rule {
 			if !ok {
 				return false, nil
 			}
-
-			if c.Duration != 0 && !overwrite {
-				return false, nil
-			}
+			c.Weekday = pointer.ToInt(dayInt)
 
 			// Switch:
 			switch {
@@ -98,7 +92,22 @@ func Weekday(s rules.Strategy) rules.Rule {
 				}
 			}
 
+			// the day of the week named after it, the weeks start on monday
+			if week := strings.ToLower(m.Captures[2]); week != "" {
+				shift := 0
+				switch week {
+				case "volgende", "komende":
+					shift = 1
+				case "vorige", "afgelopen":
+					shift = -1
+				}
+				from := (int(ref.Weekday()) + 6) % 7
+				to := (dayInt + 6) % 7
+				c.Duration = time.Duration(shift*7+to-from) * 24 * time.Hour
+			}
+
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
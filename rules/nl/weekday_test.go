@@ -35,6 +35,9 @@ func TestWeekday(t *testing.T) {
 		{"volgende week vrijdag", 0, "volgende week vrijdag", 9 * 24 * time.Hour},
 		{"volgende week zaterdag", 0, "volgende week zaterdag", 10 * 24 * time.Hour},
 		{"volgende week zondag", 0, "volgende week zondag", 11 * 24 * time.Hour},
+		// the day of the week named after it
+		{"vrijdag volgende week", 0, "vrijdag volgende", 9 * 24 * time.Hour},
+		{"maandag vorige week", 0, "maandag vorige", -(9 * 24 * time.Hour)},
 		// next
 		{"komende dinsdag", 0, "komende dinsdag", 6 * 24 * time.Hour},
 		{"stuur me een bericht komende woensdag", 21, "komende woensdag", 7 * 24 * time.Hour},
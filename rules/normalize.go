@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Offsets maps the byte offsets of a rewritten text to the ones of the
+// text it was rewritten from. It holds an offset for each byte of the
+// rewritten text and one for its end, nil stands for the text left as is.
+type Offsets []int
+
+// Map returns the offset in the original text of the offset i in the
+// rewritten one
+func (o Offsets) Map(i int) int {
+	switch {
+	case o == nil:
+		return i
+	case i < 0:
+		return o[0]
+	case i >= len(o):
+		return o[len(o)-1]
+	}
+	return o[i]
+}
+
+// Diff returns the offsets of a text rewritten with no offsets at hand. It
+// finds the part of the text the rewrite changed, the offsets within the
+// part point to its start.
+func Diff(from, to string) Offsets {
+	if from == to {
+		return nil
+	}
+
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && from[prefix] == to[prefix] {
+		prefix++
+	}
+	for prefix > 0 && prefix < len(from) && !utf8.RuneStart(from[prefix]) {
+		prefix--
+	}
+
+	suffix := 0
+	for suffix < len(from)-prefix && suffix < len(to)-prefix &&
+		from[len(from)-1-suffix] == to[len(to)-1-suffix] {
+		suffix++
+	}
+	for suffix > 0 && !utf8.RuneStart(from[len(from)-suffix]) {
+		suffix--
+	}
+
+	offsets := make(Offsets, len(to)+1)
+	for i := range offsets {
+		switch {
+		case i <= prefix:
+			offsets[i] = i
+		case i >= len(to)-suffix:
+			offsets[i] = i - len(to) + len(from)
+		default:
+			offsets[i] = prefix
+		}
+	}
+	return offsets
+}
+
+// Normalizer rewrites the text before the rules are applied and maps the
+// rewritten text back to the given one, so the results point into the
+// original text
+type Normalizer func(string) (string, Offsets)
+
+// Digits is a Normalizer replacing the decimal digits of all the scripts,
+// like the Arabic-Indic "٣", the Persian "۳", the Devanagari "३" or the
+// full-width "３", with the ASCII ones, so the rules matching [0-9] match
+// them too.
+func Digits(text string) (string, Offsets) {
+	if !hasDigits(text) {
+		return text, nil
+	}
+
+	var b strings.Builder
+	b.Grow(len(text))
+	offsets := make(Offsets, 0, len(text)+1)
+
+	for i, r := range text {
+		if d, ok := digit(r); ok {
+			b.WriteByte(byte('0' + d))
+			offsets = append(offsets, i)
+			continue
+		}
+		n := utf8.RuneLen(r)
+		if n < 0 {
+			// the replacement rune of an invalid byte
+			n = 1
+		}
+		b.WriteString(text[i : i+n])
+		for j := 0; j < n; j++ {
+			offsets = append(offsets, i+j)
+		}
+	}
+	offsets = append(offsets, len(text))
+
+	return b.String(), offsets
+}
+
+// hasDigits tells if the text has any digits but the ASCII ones
+func hasDigits(text string) bool {
+	for _, r := range text {
+		if r >= utf8.RuneSelf && unicode.IsDigit(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// digit returns the value of a decimal digit other than the ASCII ones.
+// Unicode keeps the decimal digits of a script in a row from zero to nine,
+// so the value is the distance from the start of the row.
+func digit(r rune) (int, bool) {
+	if r < utf8.RuneSelf {
+		return 0, false
+	}
+	for _, rg := range unicode.Nd.R16 {
+		if r >= rune(rg.Lo) && r <= rune(rg.Hi) {
+			return int(r-rune(rg.Lo)) % 10, true
+		}
+	}
+	for _, rg := range unicode.Nd.R32 {
+		if r >= rune(rg.Lo) && r <= rune(rg.Hi) {
+			return int(r-rune(rg.Lo)) % 10, true
+		}
+	}
+	return 0, false
+}
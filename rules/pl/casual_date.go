@@ -0,0 +1,26 @@
+package pl
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"dziś", "dzisiaj", "teraz" -> today
+	"jutro" -> tomorrow
+	"pojutrze" -> the day after tomorrow
+	"wczoraj" -> yesterday
+	"przedwczoraj" -> the day before yesterday
+*/
+
+func CasualDate(s rules.Strategy) rules.Rule {
+	return slavic.CasualDate(s, map[string]int{
+		"dziś":         0,
+		"dzisiaj":      0,
+		"teraz":        0,
+		"jutro":        1,
+		"pojutrze":     2,
+		"wczoraj":      -1,
+		"przedwczoraj": -2,
+	})
+}
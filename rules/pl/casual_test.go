@@ -0,0 +1,75 @@
+package pl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/pl"
+)
+
+func TestCasualDate(t *testing.T) {
+	fixt := []Fixture{
+		{"teraz", 0, "teraz", 0},
+		{"dziś", 0, "dziś", 0},
+		{"dzisiaj", 0, "dzisiaj", 0},
+		{"jutro", 0, "jutro", 24 * time.Hour},
+		{"pojutrze", 0, "pojutrze", 2 * 24 * time.Hour},
+		{"wczoraj", 0, "wczoraj", -(24 * time.Hour)},
+		{"przedwczoraj", 0, "przedwczoraj", -(2 * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(pl.CasualDate(rules.Skip))
+
+	ApplyFixtures(t, "pl.CasualDate", w, fixt)
+}
+
+func TestCasualTime(t *testing.T) {
+	fixt := []Fixture{
+		{"rano", 0, "rano", 8 * time.Hour},
+		{"po południu", 0, "po południu", 15 * time.Hour},
+		{"wieczorem", 0, "wieczorem", 18 * time.Hour},
+		{"w nocy", 0, "w nocy", 23 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(pl.CasualTime(rules.Skip))
+
+	ApplyFixtures(t, "pl.CasualTime", w, fixt)
+}
+
+func TestCasualDateCasualTime(t *testing.T) {
+	fixt := []Fixture{
+		{"jutro rano", 0, "jutro rano", (24 + 8) * time.Hour},
+		{"wczoraj wieczorem", 0, "wczoraj wieczorem", (18 - 24) * time.Hour},
+		{"dziś po południu", 0, "dziś po południu", 15 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(
+		pl.CasualDate(rules.Skip),
+		pl.CasualTime(rules.Override),
+	)
+
+	ApplyFixtures(t, "pl.CasualDate|pl.CasualTime", w, fixt)
+}
+
+func TestMidnight(t *testing.T) {
+	fixt := []Fixture{
+		{"o północy", 0, "o północy", 24 * time.Hour},
+		{"w południe", 0, "w południe", 12 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(pl.Midnight(rules.Override))
+
+	ApplyFixtures(t, "pl.Midnight", w, fixt)
+
+	fixtnil := []Fixture{
+		{"po południu", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "pl.Midnight nil", w, fixtnil)
+}
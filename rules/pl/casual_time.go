@@ -0,0 +1,25 @@
+package pl
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"rano", "rankiem" -> 8:00
+	"po południu" -> 15:00
+	"wieczorem" -> 18:00
+	"w nocy", "nocą" -> 23:00
+*/
+
+func CasualTime(s rules.Strategy) rules.Rule {
+	return slavic.CasualTime(s, map[string]common.DayPart{
+		"rano":        common.Morning,
+		"rankiem":     common.Morning,
+		"po południu": common.Afternoon,
+		"wieczorem":   common.Evening,
+		"w nocy":      common.Night,
+		"nocą":        common.Night,
+	})
+}
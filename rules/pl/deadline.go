@@ -0,0 +1,17 @@
+package pl
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"za 3 dni"
+	"za pięć minut"
+	"w ciągu godziny"
+	"za pół godziny"
+*/
+
+func Deadline(s rules.Strategy) rules.Rule {
+	return slavic.Deadline(s, `za|w\s+ciągu`, RELATIVE_WORDS)
+}
@@ -0,0 +1,31 @@
+package pl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/pl"
+)
+
+func TestDeadline(t *testing.T) {
+	fixt := []Fixture{
+		{"za 3 dni", 0, "za 3 dni", 3 * 24 * time.Hour},
+		{"za pięć minut", 0, "za pięć minut", 5 * time.Minute},
+		{"w ciągu godziny", 0, "w ciągu godziny", time.Hour},
+		{"za pół godziny", 0, "za pół godziny", 30 * time.Minute},
+		{"za półtorej godziny", 0, "za półtorej godziny", 90 * time.Minute},
+		{"za 5 sekund", 0, "za 5 sekund", 5 * time.Second},
+		{"za tydzień", 0, "za tydzień", 7 * 24 * time.Hour},
+		{"za dwa tygodnie", 0, "za dwa tygodnie", 14 * 24 * time.Hour},
+		{"za miesiąc", 0, "za miesiąc", 31 * 24 * time.Hour},
+		{"za rok", 0, "za rok", 366 * 24 * time.Hour},
+		{"za dobę", 0, "za dobę", 24 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(pl.Deadline(rules.Skip))
+
+	ApplyFixtures(t, "pl.Deadline", w, fixt)
+}
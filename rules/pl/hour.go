@@ -0,0 +1,17 @@
+package pl
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"o 9", "o godzinie 9" -> 9:00
+	"o dziewiątej" -> 9:00
+	"o siedemnastej" -> 17:00
+	"o 5 po południu", "o piątej wieczorem" -> 17:00
+*/
+
+func Hour(s rules.Strategy) rules.Rule {
+	return slavic.Hour(s, CLOCK_WORDS)
+}
@@ -0,0 +1,15 @@
+package pl
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"17:30", "o 17:30" -> 17:30
+	"5:30 po południu" -> 17:30
+*/
+
+func HourMinute(s rules.Strategy) rules.Rule {
+	return slavic.HourMinute(s, CLOCK_WORDS)
+}
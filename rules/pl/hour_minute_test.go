@@ -0,0 +1,24 @@
+package pl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/pl"
+)
+
+func TestHourMinute(t *testing.T) {
+	fixt := []Fixture{
+		{"17:30", 0, "17:30", 17*time.Hour + 30*time.Minute},
+		{"o 17:30", 0, "o 17:30", 17*time.Hour + 30*time.Minute},
+		{"o godzinie 9:15", 0, "o godzinie 9:15", 9*time.Hour + 15*time.Minute},
+		{"5:30 po południu", 0, "5:30 po południu", 17*time.Hour + 30*time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Add(pl.HourMinute(rules.Override))
+
+	ApplyFixtures(t, "pl.HourMinute", w, fixt)
+}
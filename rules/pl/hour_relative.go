@@ -0,0 +1,86 @@
+package pl
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"wpół do ósmej" -> 7:30
+	"kwadrans po siódmej" -> 7:15
+	"dziesięć po siódmej" -> 7:10
+	"za kwadrans ósma" -> 7:45
+	"za dziesięć ósma" -> 7:50
+	"wpół do ósmej wieczorem" -> 19:30
+
+	"wpół do" and "za" name the coming hour, "po" the past one.
+*/
+
+func HourRelative(s rules.Strategy) rules.Rule {
+	ordinals := slavic.Words(slavic.Keys(HOUR_ORDINALS)...)
+	minutes := slavic.Words(slavic.Keys(MINUTE_WORDS)...) + "|[0-9]{1,2}"
+
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:[^\\p{L}\\p{N}]|^)" +
+			"(?:(o)\\s+)?" +
+			"(?:(wpół\\s+do)\\s+(" + ordinals + ")" +
+			"|(" + minutes + ")(?:\\s+minut\\p{L}*)?\\s+po\\s+(" + ordinals + ")" +
+			"|(za)\\s+(" + minutes + ")\\s+(" + ordinals + "))" +
+			"(?:\\s+(" + CLOCK_WORDS.PartsPattern() + "))?" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			var ordinal, minutes string
+			switch {
+			case m.Captures[1] != "":
+				ordinal = m.Captures[2]
+			case m.Captures[3] != "":
+				ordinal, minutes = m.Captures[4], m.Captures[3]
+			default:
+				ordinal, minutes = m.Captures[7], m.Captures[6]
+			}
+
+			hour, ok := slavic.Lookup(ordinal, HOUR_ORDINALS)
+			if !ok {
+				return false, nil
+			}
+			if m.Captures[3] == "" {
+				hour--
+			}
+			if hour == 0 {
+				hour = 12
+			}
+
+			n := 30
+			if minutes != "" {
+				if n, ok = slavic.Lookup(minutes, MINUTE_WORDS); !ok {
+					n, _ = strconv.Atoi(minutes)
+				}
+				if n <= 0 || n >= 60 {
+					return false, nil
+				}
+				if m.Captures[5] != "" {
+					n = 60 - n
+				}
+			}
+
+			if part, ok := CLOCK_WORDS.Part(m.Captures[8]); ok {
+				if hour > 12 {
+					return false, nil
+				}
+				hour = part.Clock(hour, o)
+			}
+
+			c.Hour = &hour
+			c.Minute = &n
+			c.Second = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,37 @@
+package pl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/pl"
+)
+
+func TestHourRelative(t *testing.T) {
+	fixt := []Fixture{
+		{"wpół do ósmej", 0, "wpół do ósmej", 7*time.Hour + 30*time.Minute},
+		{"o wpół do dziewiątej", 0, "o wpół do dziewiątej", 8*time.Hour + 30*time.Minute},
+		{"kwadrans po siódmej", 0, "kwadrans po siódmej", 7*time.Hour + 15*time.Minute},
+		{"dziesięć po siódmej", 0, "dziesięć po siódmej", 7*time.Hour + 10*time.Minute},
+		{"20 minut po ósmej", 0, "20 minut po ósmej", 8*time.Hour + 20*time.Minute},
+		{"za kwadrans ósma", 0, "za kwadrans ósma", 7*time.Hour + 45*time.Minute},
+		{"za dziesięć ósma", 0, "za dziesięć ósma", 7*time.Hour + 50*time.Minute},
+		{"wpół do ósmej wieczorem", 0, "wpół do ósmej wieczorem", 19*time.Hour + 30*time.Minute},
+		{"wpół do pierwszej", 0, "wpół do pierwszej", 12*time.Hour + 30*time.Minute},
+		{"kwadrans po dwunastej w nocy", 0, "kwadrans po dwunastej w nocy", 24*time.Hour + 15*time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Add(pl.HourRelative(rules.Override))
+
+	ApplyFixtures(t, "pl.HourRelative", w, fixt)
+
+	fixtnil := []Fixture{
+		{"za 75 ósma", 0, "", 0},
+		{"ósma", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "pl.HourRelative nil", w, fixtnil)
+}
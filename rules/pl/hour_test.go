@@ -0,0 +1,37 @@
+package pl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/pl"
+)
+
+func TestHour(t *testing.T) {
+	fixt := []Fixture{
+		{"o 9", 0, "o 9", 9 * time.Hour},
+		{"o godzinie 9", 0, "o godzinie 9", 9 * time.Hour},
+		{"o godz. 21", 0, "o godz. 21", 21 * time.Hour},
+		{"o dziewiątej", 0, "o dziewiątej", 9 * time.Hour},
+		{"o siedemnastej", 0, "o siedemnastej", 17 * time.Hour},
+		{"o drugiej w nocy", 0, "o drugiej w nocy", 2 * time.Hour},
+		{"o 5 po południu", 0, "o 5 po południu", 17 * time.Hour},
+		{"o piątej wieczorem", 0, "o piątej wieczorem", 17 * time.Hour},
+		{"8 rano", 0, "8 rano", 8 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(pl.Hour(rules.Override))
+
+	ApplyFixtures(t, "pl.Hour", w, fixt)
+
+	fixtnil := []Fixture{
+		{"5 minut", 0, "", 0},
+		{"dziewiątej", 0, "", 0},
+		{"o 25", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "pl.Hour nil", w, fixtnil)
+}
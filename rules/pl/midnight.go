@@ -0,0 +1,20 @@
+package pl
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"o północy" -> the end of today
+	"w piątek o północy" -> the end of friday, 00:00 on saturday
+	"jutro w południe" -> 12:00 tomorrow
+*/
+
+// Midnight handles midnight and noon
+func Midnight(s rules.Strategy) rules.Rule {
+	return slavic.Midnight(s,
+		[]string{"o północy", "północ"},
+		[]string{"w południe", "południe"},
+	)
+}
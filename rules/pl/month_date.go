@@ -0,0 +1,15 @@
+package pl
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"5 marca" -> March 5
+	"5 marca 2017 r." -> March 5, 2017
+*/
+
+func MonthDate(s rules.Strategy) rules.Rule {
+	return slavic.MonthDate(s, MONTH_WORDS)
+}
@@ -0,0 +1,32 @@
+package pl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/pl"
+)
+
+func TestMonthDate(t *testing.T) {
+	fixt := []Fixture{
+		{"5 marca", 0, "5 marca", time.Date(2016, 3, 5, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"31 grudnia", 0, "31 grudnia", time.Date(2016, 12, 31, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"3 maja", 0, "3 maja", time.Date(2016, 5, 3, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"5 marca 2017", 0, "5 marca 2017", time.Date(2017, 3, 5, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"5 marca 2017 roku", 0, "5 marca 2017 roku", time.Date(2017, 3, 5, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"1 stycznia 2017 r.", 0, "1 stycznia 2017 r.", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC).Sub(null)},
+	}
+
+	w := when.New(nil)
+	w.Add(pl.MonthDate(rules.Override))
+
+	ApplyFixtures(t, "pl.MonthDate", w, fixt)
+
+	fixtnil := []Fixture{
+		{"35 marca", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "pl.MonthDate nil", w, fixtnil)
+}
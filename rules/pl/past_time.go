@@ -0,0 +1,17 @@
+package pl
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"5 minut temu"
+	"godzinę temu"
+	"dwa dni temu"
+	"kilka miesięcy temu"
+*/
+
+func PastTime(s rules.Strategy) rules.Rule {
+	return slavic.PastTime(s, `temu`, RELATIVE_WORDS)
+}
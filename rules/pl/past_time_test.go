@@ -0,0 +1,35 @@
+package pl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/pl"
+)
+
+func TestPastTime(t *testing.T) {
+	fixt := []Fixture{
+		{"5 minut temu", 0, "5 minut temu", -(5 * time.Minute)},
+		{"to było 5 minut temu", 9, "5 minut temu", -(5 * time.Minute)},
+		{"godzinę temu", 0, "godzinę temu", -(time.Hour)},
+		{"pół godziny temu", 0, "pół godziny temu", -(time.Hour / 2)},
+		{"dwa dni temu", 0, "dwa dni temu", -(2 * 24 * time.Hour)},
+		{"tydzień temu", 0, "tydzień temu", -(7 * 24 * time.Hour)},
+		{"kilka miesięcy temu", 0, "kilka miesięcy temu", -(92 * 24 * time.Hour)},
+		{"rok temu", 0, "rok temu", -(365 * 24 * time.Hour)},
+		{"dwa lata temu", 0, "dwa lata temu", -((365 + 365) * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(pl.PastTime(rules.Skip))
+
+	ApplyFixtures(t, "pl.PastTime", w, fixt)
+
+	fixtnil := []Fixture{
+		{"temu", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "pl.PastTime nil", w, fixtnil)
+}
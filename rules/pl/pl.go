@@ -0,0 +1,207 @@
+package pl
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/olebedev/when/rules/romance"
+	"github.com/olebedev/when/rules/slavic"
+	"regexp"
+)
+
+var describe = rules.Describer("pl")
+
+var All = []rules.Rule{
+	describe("weekday", rules.Date, 70, Weekday(rules.Override)),
+	describe("relative_week", rules.Range, 70, RelativeWeek(rules.Override)),
+	describe("casual_date", rules.Date, 60, CasualDate(rules.Override)),
+	describe("casual_time", rules.Time, 60, CasualTime(rules.Override)),
+	describe("midnight", rules.Time, 60, Midnight(rules.Override)),
+	describe("military_time", rules.Time, 50, common.MilitaryTime(rules.Override)),
+	describe("hour", rules.Time, 50, Hour(rules.Override)),
+	describe("hour_minute", rules.Time, 50, HourMinute(rules.Override)),
+	describe("hour_relative", rules.Time, 50, HourRelative(rules.Override)),
+	describe("deadline", rules.Duration, 40, Deadline(rules.Override)),
+	describe("past_time", rules.Duration, 40, PastTime(rules.Override)),
+	describe("month_date", rules.Date, 30, MonthDate(rules.Override)),
+}
+
+// CONNECTORS holds the words joining the parts of a time, like "o" of
+// "jutro, po spotkaniu, o 15:00", see rules.ClusterByTokens
+var CONNECTORS = []string{"w", "we", "o", "na", "do", "od", "około"}
+
+// LABELS are the words labeling the numbers of the things, like "pokój" of
+// "pokój 1200", see STOPS
+var LABELS = []string{
+	"pokój", "pokoju", "sala", "sali", "salę", "wersja", "wersji", "nr",
+	"numer", "zamówienie", "lot", "strona", "stronę", "stronie", "rozdział",
+	"mieszkanie",
+}
+
+// STOPS keep the numbers of the things from being read as a time or a date,
+// like "pokój 1200" or "wersja 2.0", see Parser.Stop of the when package
+var STOPS = []*regexp.Regexp{
+	rules.Labeled(LABELS...),
+}
+
+// WEEKDAY_OFFSET holds the forms of the weekdays in the cases they are
+// said in, "niedziela", "w niedzielę", "do niedzieli"
+var WEEKDAY_OFFSET = slavic.Merge(
+	slavic.Decline(map[string]int{"niedziel": 0}, "a", "ę", "i", "ą"),
+	slavic.Decline(map[string]int{"poniedział": 1, "wtor": 2, "czwart": 4, "piąt": 5}, "ek", "ku", "kiem"),
+	slavic.Decline(map[string]int{"środ": 3, "sobot": 6}, "a", "ę", "y", "ą"),
+	map[string]int{"środzie": 3, "sobocie": 6},
+)
+
+// MONTH_OFFSET holds the stems of the months, "marzec", "5 marca"
+var MONTH_OFFSET = map[string]int{
+	"stycz":       1,
+	"lut":         2,
+	"marz":        3,
+	"marc":        3,
+	"kwie":        4,
+	"maj":         5,
+	"czerw":       6,
+	"lip":         7,
+	"sierp":       8,
+	"wrze":        9,
+	"październik": 10,
+	"listopad":    11,
+	"grud":        12,
+}
+
+// HOUR_ORDINALS holds the feminine ordinals naming the hours, "ósma",
+// "o ósmej"
+var HOUR_ORDINALS = slavic.Merge(
+	slavic.Decline(map[string]int{
+		"pierwsz":      1,
+		"trzeci":       3,
+		"czwart":       4,
+		"piąt":         5,
+		"szóst":        6,
+		"siódm":        7,
+		"ósm":          8,
+		"dziewiąt":     9,
+		"dziesiąt":     10,
+		"jedenast":     11,
+		"dwunast":      12,
+		"trzynast":     13,
+		"czternast":    14,
+		"piętnast":     15,
+		"szesnast":     16,
+		"siedemnast":   17,
+		"osiemnast":    18,
+		"dziewiętnast": 19,
+		"dwudziest":    20,
+	}, "a", "ej"),
+	slavic.Decline(map[string]int{"drug": 2}, "a", "iej"),
+)
+
+// MINUTE_WORDS holds the minutes said around an hour, like "kwadrans" in
+// "kwadrans po ósmej"
+var MINUTE_WORDS = map[string]int{
+	"pięć":             5,
+	"dziesięć":         10,
+	"kwadrans":         15,
+	"piętnaście":       15,
+	"dwadzieścia":      20,
+	"dwadzieścia pięć": 25,
+}
+
+var DAY_PARTS = map[string]common.DayPart{
+	"rano":        common.Morning,
+	"po południu": common.Afternoon,
+	"wieczorem":   common.Evening,
+	"w nocy":      common.Night,
+}
+
+var CLOCK_WORDS = &slavic.ClockWords{
+	At:       []string{"o", "o godzinie", "o godz."},
+	Ordinals: HOUR_ORDINALS,
+	Parts:    DAY_PARTS,
+}
+
+var WEEKDAY_WORDS = &slavic.WeekdayWords{
+	Offsets:      WEEKDAY_OFFSET,
+	Prepositions: []string{"w", "we", "do", "na"},
+	Next:         []string{"następn", "przysz", "kolejn"},
+	Last:         []string{"zeszł", "ubiegł", "poprzedni", "ostatni"},
+	This:         []string{"ten", "tę", "tym", "tej", "tego"},
+}
+
+var MONTH_WORDS = &slavic.MonthWords{
+	Months: MONTH_OFFSET,
+	Year:   []string{"roku", "r."},
+}
+
+// RELATIVE_WORDS holds the words of the deadlines and the past times, the
+// amounts in all the forms they agree with their unit in
+var RELATIVE_WORDS = &slavic.Relative{
+	Amounts: map[string]float64{
+		"jeden":       1,
+		"jedna":       1,
+		"jedną":       1,
+		"jedno":       1,
+		"jednego":     1,
+		"jednej":      1,
+		"dwa":         2,
+		"dwie":        2,
+		"dwóch":       2,
+		"parę":        2,
+		"trzy":        3,
+		"trzech":      3,
+		"kilka":       3,
+		"kilku":       3,
+		"cztery":      4,
+		"czterech":    4,
+		"pięć":        5,
+		"pięciu":      5,
+		"sześć":       6,
+		"siedem":      7,
+		"osiem":       8,
+		"dziewięć":    9,
+		"dziesięć":    10,
+		"jedenaście":  11,
+		"dwanaście":   12,
+		"piętnaście":  15,
+		"dwadzieścia": 20,
+		"trzydzieści": 30,
+		"pół":         0.5,
+		"półtora":     1.5,
+		"półtorej":    1.5,
+	},
+	Units: map[string]romance.Unit{
+		"sekund": romance.Seconds,
+		"minut":  romance.Minutes,
+		"godzin": romance.Hours,
+		"dzień":  romance.Days,
+		"dni":    romance.Days,
+		"dob":    romance.Days,
+		"tydz":   romance.Weeks,
+		"tygod":  romance.Weeks,
+		"miesi":  romance.Months,
+		"rok":    romance.Years,
+		"lat":    romance.Years,
+	},
+}
+
+var PERIOD_WORDS = &slavic.PeriodWords{
+	Periods: map[string]common.Period{
+		"tydz":  common.Week,
+		"tygod": common.Week,
+		"miesi": common.Month,
+		"rok":   common.Year,
+	},
+	Shifts: map[string]int{
+		"następn":   1,
+		"przysz":    1,
+		"kolejn":    1,
+		"zeszł":     -1,
+		"ubiegł":    -1,
+		"poprzedni": -1,
+		"ten":       0,
+		"tym":       0,
+		"tego":      0,
+		"bieżąc":    0,
+	},
+	Prepositions: []string{"w", "we"},
+}
@@ -0,0 +1,59 @@
+package pl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules/pl"
+	"github.com/stretchr/testify/require"
+)
+
+var null = time.Date(2016, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+type Fixture struct {
+	Text   string
+	Index  int
+	Phrase string
+	Diff   time.Duration
+}
+
+func ApplyFixtures(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d - %s", name, i, f.Text)
+		require.NotNil(t, res, "[%s] res #%d - %s", name, i, f.Text)
+		require.Equal(t, f.Index, res.Index, "[%s] index #%d - %s", name, i, f.Text)
+		require.Equal(t, f.Phrase, res.Text, "[%s] text #%d - %s", name, i, f.Text)
+		require.Equal(t, f.Diff, res.Time.Sub(null), "[%s] diff #%d - %s", name, i, f.Text)
+	}
+}
+
+func ApplyFixturesNil(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d", name, i)
+		require.Nil(t, res, "[%s] res #%d", name, i)
+	}
+}
+
+func TestAll(t *testing.T) {
+	w := when.New(nil)
+	w.Add(pl.All...)
+
+	// complex cases
+	fixt := []Fixture{
+		{"jutro o 9", 0, "jutro o 9", (24 + 9) * time.Hour},
+		{"przypomnij za 3 dni", 11, "za 3 dni", 3 * 24 * time.Hour},
+		{"widzimy się w piątek", 13, "w piątek", 2 * 24 * time.Hour},
+		{"w piątek o 18:30", 0, "w piątek o 18:30", (2*24+18)*time.Hour + 30*time.Minute},
+		{"w następny poniedziałek wieczorem", 0, "w następny poniedziałek wieczorem", (5*24 + 18) * time.Hour},
+		{"dziś wieczorem", 0, "dziś wieczorem", 18 * time.Hour},
+		{"pojutrze o dziewiątej rano", 0, "pojutrze o dziewiątej rano", (2*24 + 9) * time.Hour},
+		{"jutro o siedemnastej", 0, "jutro o siedemnastej", (24 + 17) * time.Hour},
+		{"spotkajmy się o wpół do dziewiątej", 15, "o wpół do dziewiątej", 8*time.Hour + 30*time.Minute},
+		{"spotkanie 5 marca 2017 r. o 10:00", 10, "5 marca 2017 r. o 10:00", time.Date(2017, 3, 5, 10, 0, 0, 0, time.UTC).Sub(null)},
+	}
+
+	ApplyFixtures(t, "pl.All...", w, fixt)
+}
@@ -0,0 +1,16 @@
+package pl
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"w przyszłym tygodniu", "w następnym tygodniu" -> 9:00 on the next Monday
+	"w zeszłym miesiącu" -> the previous month
+	"w tym roku" -> the current year
+*/
+
+func RelativeWeek(s rules.Strategy) rules.Rule {
+	return slavic.RelativeWeek(s, PERIOD_WORDS)
+}
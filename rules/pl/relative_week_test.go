@@ -0,0 +1,28 @@
+package pl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/pl"
+)
+
+func TestRelativeWeek(t *testing.T) {
+	fixt := []Fixture{
+		{"w przyszłym tygodniu", 0, "w przyszłym tygodniu", (5*24 + 9) * time.Hour},
+		{"w następnym tygodniu", 0, "w następnym tygodniu", (5*24 + 9) * time.Hour},
+		{"w zeszłym tygodniu", 0, "w zeszłym tygodniu", -(7 * 24 * time.Hour)},
+		{"w tym tygodniu", 0, "w tym tygodniu", 0},
+		{"w przyszłym miesiącu", 0, "w przyszłym miesiącu", (26*24 + 9) * time.Hour},
+		{"w ubiegłym miesiącu", 0, "w ubiegłym miesiącu", -(31 * 24 * time.Hour)},
+		{"w przyszłym roku", 0, "w przyszłym roku", 366 * 24 * time.Hour},
+		{"w tym roku", 0, "w tym roku", 0},
+	}
+
+	w := when.New(nil)
+	w.Add(pl.RelativeWeek(rules.Override))
+
+	ApplyFixtures(t, "pl.RelativeWeek", w, fixt)
+}
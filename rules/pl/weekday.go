@@ -0,0 +1,17 @@
+package pl
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"w piątek", "we wtorek" -> the upcoming day
+	"w następny poniedziałek", "w przyszłą środę" -> the upcoming day
+	"w zeszły czwartek" -> the past Thursday
+	"w tę sobotę" -> Saturday of the current week
+*/
+
+func Weekday(s rules.Strategy) rules.Rule {
+	return slavic.Weekday(s, WEEKDAY_WORDS)
+}
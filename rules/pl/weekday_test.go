@@ -0,0 +1,37 @@
+package pl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/pl"
+)
+
+func TestWeekday(t *testing.T) {
+	// current is Wednesday
+	fixt := []Fixture{
+		{"w piątek", 0, "w piątek", 2 * 24 * time.Hour},
+		{"we wtorek", 0, "we wtorek", 6 * 24 * time.Hour},
+		{"w niedzielę", 0, "w niedzielę", 4 * 24 * time.Hour},
+		{"w środę", 0, "w środę", 7 * 24 * time.Hour},
+		{"do soboty", 0, "do soboty", 3 * 24 * time.Hour},
+		{"w następny poniedziałek", 0, "w następny poniedziałek", 5 * 24 * time.Hour},
+		{"w przyszłą środę", 0, "w przyszłą środę", 7 * 24 * time.Hour},
+		{"w zeszły czwartek", 0, "w zeszły czwartek", -(6 * 24 * time.Hour)},
+		{"w ostatni poniedziałek", 0, "w ostatni poniedziałek", -(2 * 24 * time.Hour)},
+		{"w ten czwartek", 0, "w ten czwartek", 24 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(pl.Weekday(rules.Override))
+
+	ApplyFixtures(t, "pl.Weekday", w, fixt)
+
+	fixtnil := []Fixture{
+		{"piątkowy wieczór", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "pl.Weekday nil", w, fixtnil)
+}
@@ -0,0 +1,171 @@
+package romance
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/pkg/errors"
+)
+
+// ClockWords holds the words of the times of a language, all the patterns
+// but At are required
+type ClockWords struct {
+	// At precedes an hour said without its unit, like "a las" in "a las
+	// tres", optional
+	At string
+
+	// Hours follows an hour, like "h" or "heures"
+	Hours string
+
+	// Integers holds the spelled-out hours, IntegersPattern matches them
+	Integers        map[string]int
+	IntegersPattern string
+
+	// Minutes maps the fractions following an hour to their minutes, like
+	// "et demie" to 30 or "menos cuarto" to -15, MinutesPattern matches them
+	Minutes        map[string]int
+	MinutesPattern string
+
+	// Morning, Afternoon and Night follow a time of a 12-hour clock, like
+	// "du matin", "de la tarde" or "de la noche"
+	Morning, Afternoon, Night string
+}
+
+// dayPart returns the pattern of the parts of the day following a time,
+// capturing each of them
+func (w *ClockWords) dayPart() string {
+	return "(?:\\s+(?:(" + w.Morning + ")|(" + w.Afternoon + ")|(" + w.Night + ")))?"
+}
+
+// clock turns the hour of a 12-hour clock to the one of the part of the day
+// captured by dayPart
+func clock(hour int, captures []string, o *rules.Options) int {
+	switch {
+	case captures[0] != "":
+		if hour == 12 {
+			hour = 0
+		}
+	case captures[1] != "":
+		if hour < 12 {
+			hour += 12
+		}
+	case captures[2] != "":
+		hour = common.Night.Clock(hour, o)
+	}
+	return hour
+}
+
+/*
+	"15h", "à 15 heures" -> 15:00
+	"a las tres" -> 3:00
+	"3 heures de l'après-midi", "a las 3 de la tarde" -> 15:00
+	"à 3 heures et demie" -> 3:30
+	"a las 3 menos cuarto" -> 2:45
+*/
+
+// Hour builds a rule for the hours said with their unit or after the words
+// of At
+func Hour(s rules.Strategy, w *ClockWords) rules.Rule {
+	number := "[0-9]{1,2}|" + w.IntegersPattern
+
+	at := "(\\b\\B)()()"
+	if w.At != "" {
+		at = "(" + w.At + ")\\s+(" + number + ")(?:\\s*(" + w.Hours + "))?"
+	}
+
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:[^\\p{L}\\p{N}]|^)" +
+			"(?:" + at + "|(" + number + ")\\s*(" + w.Hours + "))" +
+			"(?:\\s+(" + w.MinutesPattern + "))?" +
+			w.dayPart() +
+			// not the hour of "10:30", it's the one of HourMinute
+			"(?:[^\\p{L}\\p{N}:]|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			numStr := strings.ToLower(m.Captures[1] + m.Captures[3])
+			hour, ok := w.Integers[numStr]
+			if !ok {
+				var err error
+				hour, err = strconv.Atoi(numStr)
+				if err != nil {
+					return false, errors.Wrap(err, "hour rule")
+				}
+			}
+			if hour > 23 {
+				return false, nil
+			}
+
+			minutes := 0
+			if m.Captures[5] != "" {
+				minutes, ok = w.Minutes[strings.Join(strings.Fields(strings.ToLower(m.Captures[5])), " ")]
+				if !ok {
+					return false, nil
+				}
+			}
+
+			hour = clock(hour, m.Captures[6:9], o)
+			if minutes < 0 {
+				minutes += 60
+				hour = (hour + 23) % 24
+			}
+
+			seconds := 0
+			c.Hour = &hour
+			c.Minute = &minutes
+			c.Second = &seconds
+			if hour >= 1 && hour <= 11 && strings.Join(m.Captures[6:9], "") == "" {
+				c.AmbiguousClock(hour)
+			}
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
+
+/*
+	"14h30", "14 h 30" -> 14:30
+	"14:30", "a las 14:30" -> 14:30
+	"8h15 du soir", "8:15 de la noche" -> 20:15
+*/
+
+// HourMinute builds a rule for the times of a 24-hour clock, after the
+// words of At or not
+func HourMinute(s rules.Strategy, w *ClockWords) rules.Rule {
+	at := "(\\b\\B)"
+	if w.At != "" {
+		at = "(" + w.At + ")"
+	}
+
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:[^\\p{L}\\p{N}]|^)" +
+			"(?:" + at + "\\s+)?" +
+			"([01]?[0-9]|2[0-3])\\s*(?:[:：]|h)\\s*([0-5][0-9])" +
+			w.dayPart() +
+			"(?:[^\\p{L}\\p{N}]|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			hour, err := strconv.Atoi(m.Captures[1])
+			if err != nil {
+				return false, errors.Wrap(err, "hour minute rule")
+			}
+
+			minutes, err := strconv.Atoi(m.Captures[2])
+			if err != nil {
+				return false, errors.Wrap(err, "hour minute rule")
+			}
+
+			hour = clock(hour, m.Captures[3:6], o)
+
+			seconds := 0
+			c.Hour = &hour
+			c.Minute = &minutes
+			c.Second = &seconds
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
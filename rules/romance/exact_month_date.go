@@ -0,0 +1,87 @@
+package romance
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+)
+
+// MonthWords holds the words of the dates of a language
+type MonthWords struct {
+	// Offsets maps the months to their number, Pattern matches them
+	Offsets map[string]int
+	Pattern string
+
+	// Ordinals holds the spelled-out days, like "premier" or "primero",
+	// OrdinalsPattern matches them
+	Ordinals        map[string]int
+	OrdinalsPattern string
+
+	// Of joins the day, the month and the year, like "de" in "5 de marzo
+	// de 2017", optional
+	Of string
+}
+
+/*
+	"5 mars", "le 1er mars 2017"
+	"5 de marzo", "primero de marzo de 2017"
+	"mars", "marzo"
+*/
+
+// ExactMonthDate builds a rule for the dates with a month, a day before it
+// and a year after it are optional
+func ExactMonthDate(s rules.Strategy, w *MonthWords) rules.Rule {
+	of := "\\s+"
+	if w.Of != "" {
+		of = "\\s+(?:(?:" + w.Of + ")\\s+)?"
+	}
+
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:[^\\p{L}\\p{N}]|^)" +
+			"(?:(?:([0-9]{1,2})(?:er|re|º|°)?|(" + w.OrdinalsPattern + "))" + of + ")?" +
+			"(" + w.Pattern + ")" +
+			"(?:" + of + "([0-9]{4}))?" +
+			"(?:[^\\p{L}\\p{N}]|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			month, ok := w.Offsets[strings.ToLower(m.Captures[2])]
+			if !ok {
+				return false, nil
+			}
+
+			day := 0
+			if m.Captures[0] != "" {
+				n, err := strconv.Atoi(m.Captures[0])
+				if err != nil || n < 1 || n > 31 {
+					return false, nil
+				}
+				day = n
+			}
+			if m.Captures[1] != "" {
+				if day, ok = w.Ordinals[strings.ToLower(m.Captures[1])]; !ok {
+					return false, nil
+				}
+			}
+
+			if m.Captures[3] != "" {
+				year, err := strconv.Atoi(m.Captures[3])
+				if err != nil {
+					return false, nil
+				}
+				c.Year = &year
+				common.ForgetYear(c, year)
+			}
+
+			c.Month = &month
+			if day != 0 {
+				c.Day = &day
+			}
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
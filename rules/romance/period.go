@@ -0,0 +1,65 @@
+package romance
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+)
+
+// PeriodWords holds the words of the relative periods of a language, all
+// the patterns are required
+type PeriodWords struct {
+	// Periods maps the periods to their kind, like "semaine" or "mes",
+	// Pattern matches them
+	Periods map[string]common.Period
+	Pattern string
+
+	// Shifts maps the words around a period to the number of periods they
+	// go from the current one, like "prochaine" to 1 or "pasado" to -1.
+	// Before matches the ones preceding a period, After the following ones.
+	Shifts        map[string]int
+	Before, After string
+}
+
+/*
+	"la semaine prochaine", "la próxima semana" -> 9:00 on the next Monday
+	"le mois dernier", "el mes pasado" -> the previous month
+	"cette année", "este año" -> the current year
+*/
+
+// RelativeWeek builds a rule for the weeks, months and years relative to
+// the current ones, see common.ApplyPeriod for their meaning
+func RelativeWeek(s rules.Strategy, w *PeriodWords) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"(?:(" + w.Before + ")\\s+)?" +
+			"(" + w.Pattern + ")" +
+			"(?:\\s+(" + w.After + "))?" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			if m.Captures[0] == "" && m.Captures[2] == "" {
+				return false, nil
+			}
+
+			period, ok := w.Periods[strings.ToLower(m.Captures[1])]
+			if !ok {
+				return false, nil
+			}
+
+			word := m.Captures[0]
+			if m.Captures[2] != "" {
+				word = m.Captures[2]
+			}
+			shift, ok := w.Shifts[strings.Join(strings.Fields(strings.ToLower(word)), " ")]
+			if !ok {
+				return false, nil
+			}
+
+			return common.ApplyPeriod(c, ref, period, shift), nil
+		},
+		Strategy: s,
+	}
+}
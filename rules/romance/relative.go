@@ -0,0 +1,103 @@
+package romance
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/pkg/errors"
+)
+
+// Relative holds the words of the relative expressions of a language
+type Relative struct {
+	// Amounts holds the spelled-out amounts like "deux" or "quelques",
+	// AmountsPattern matches them
+	Amounts        map[string]float64
+	AmountsPattern string
+
+	// Units maps the prefixes of the unit words to their unit, UnitsPattern
+	// matches the words
+	Units        map[string]Unit
+	UnitsPattern string
+
+	// Half follows a unit to add a half to its amount, like "et demie" in
+	// "une heure et demie", optional
+	Half string
+}
+
+// pattern returns the pattern of an amount and its unit, capturing both
+// and the half if any
+func (r *Relative) pattern() string {
+	p := "(" + r.AmountsPattern + "|[0-9]+(?:[.,][0-9]+)?)[\\s-]*(" + r.UnitsPattern + ")"
+	if r.Half != "" {
+		p += "(?:\\s+(" + r.Half + "))?"
+	} else {
+		p += "()"
+	}
+	return p
+}
+
+// apply sets the context to the amount and unit of the captures
+func (r *Relative) apply(captures []string, sign float64, c *rules.Context, ref time.Time) (bool, error) {
+	amount, err := ParseAmount(captures[0], r.Amounts)
+	if err != nil {
+		return false, err
+	}
+	if captures[2] != "" {
+		amount += 0.5
+	}
+
+	unit, ok := LookupUnit(captures[1], r.Units)
+	if !ok {
+		return false, nil
+	}
+
+	ApplyAmount(c, ref, sign*amount, unit)
+	return true, nil
+}
+
+// Deadline builds a rule for the expressions of the time to come like
+// "dans 3 jours" or "en una hora", prefix matches the words before the
+// amount
+func Deadline(s rules.Strategy, prefix string, r *Relative) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"((?:" + prefix + ")\\s+" + r.pattern() + ")" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			ok, err := r.apply(m.Captures[1:], 1, c, ref)
+			return ok, errors.Wrap(err, "deadline rule")
+		},
+		Strategy: s,
+	}
+}
+
+// PastTime builds a rule for the expressions of the past like "il y a
+// 3 jours", "hace una hora" or "dos días atrás", prefix and suffix match
+// the words before or after the amount, one of them may be empty
+func PastTime(s rules.Strategy, prefix, suffix string, r *Relative) rules.Rule {
+	// the alternatives keep their captures when missing, they never match
+	before, after := "(\\b\\B)()()", "(\\b\\B)()()()"
+	if prefix != "" {
+		before = "(?:" + prefix + ")\\s+" + r.pattern()
+	}
+	if suffix != "" {
+		after = r.pattern() + "\\s+(" + suffix + ")"
+	}
+
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"(" + before + "|" + after + ")" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			captures := m.Captures[1:4]
+			if captures[0] == "" {
+				captures = m.Captures[4:7]
+			}
+
+			ok, err := r.apply(captures, -1, c, ref)
+			return ok, errors.Wrap(err, "past time rule")
+		},
+		Strategy: s,
+	}
+}
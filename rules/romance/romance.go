@@ -0,0 +1,107 @@
+// Package romance holds the helpers and rule builders the Romance
+// languages share. A language describes its words in the tables the
+// builders take, the rules themselves live in the language packages.
+package romance
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/pkg/errors"
+)
+
+// Unit is a unit of the relative expressions like "dans 3 jours"
+type Unit int
+
+const (
+	Seconds Unit = iota
+	Minutes
+	Hours
+	Days
+	Weeks
+	Months
+	Years
+)
+
+// ParseAmount converts a digit or spelled-out amount to its value, words
+// holds the spelled-out amounts of the language
+func ParseAmount(s string, words map[string]float64) (float64, error) {
+	s = strings.ToLower(strings.Join(strings.Fields(s), " "))
+	if n, ok := words[s]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.ParseFloat(strings.Replace(s, ",", ".", 1), 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "convert '%s' to number", s)
+	}
+	return n, nil
+}
+
+// LookupUnit returns the unit of the longest prefix of word in units
+func LookupUnit(word string, units map[string]Unit) (Unit, bool) {
+	word = strings.ToLower(word)
+
+	var unit Unit
+	longest := 0
+	for prefix, u := range units {
+		if len(prefix) > longest && strings.HasPrefix(word, prefix) {
+			unit, longest = u, len(prefix)
+		}
+	}
+	return unit, longest > 0
+}
+
+// ApplyAmount sets the context to the amount of the unit from ref, months
+// and years move through the calendar while their fractions fall back to
+// thirty-day months
+func ApplyAmount(c *rules.Context, ref time.Time, amount float64, unit Unit) {
+	var duration time.Duration
+	switch unit {
+	case Seconds:
+		duration = time.Second
+	case Minutes:
+		duration = time.Minute
+	case Hours:
+		duration = time.Hour
+	case Days:
+		duration = 24 * time.Hour
+	case Weeks:
+		duration = 7 * 24 * time.Hour
+	case Months, Years:
+		months := amount
+		if unit == Years {
+			months *= 12
+		}
+		whole, fraction := math.Modf(months)
+		if whole != 0 {
+			ShiftMonths(c, ref, int(whole))
+		}
+		if fraction != 0 {
+			c.Duration = time.Duration(fraction * float64(30*24*time.Hour))
+		}
+		return
+	}
+
+	c.Duration = time.Duration(amount * float64(duration))
+}
+
+// ShiftMonths sets the year and month fields to the given number of months
+// from ref
+func ShiftMonths(c *rules.Context, ref time.Time, months int) {
+	month := int(ref.Month()) - 1 + months
+	year := ref.Year() + month/12
+	if month %= 12; month < 0 {
+		month += 12
+		year--
+	}
+
+	if year != ref.Year() {
+		c.Year = &year
+	}
+	month++
+	c.Month = &month
+}
@@ -0,0 +1,71 @@
+package romance
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+// WeekdayWords holds the words of the weekday expressions of a language,
+// all the patterns are required
+type WeekdayWords struct {
+	// Offsets maps the weekdays to their offset from Sunday, Pattern
+	// matches them
+	Offsets map[string]int
+	Pattern string
+
+	// Articles precede a weekday without changing it, like "le" or "na"
+	Articles string
+
+	// Next, Last and This precede a weekday, like "próxima" or "ce",
+	// NextAfter and LastAfter follow it, like "prochain" or "pasado"
+	Next, Last, This     string
+	NextAfter, LastAfter string
+
+	// WeekStart is the first day of the week "this" refers to
+	WeekStart time.Weekday
+}
+
+// Weekday builds a rule for the weekdays, a weekday on its own and the
+// next one are the upcoming day, the last one is the past day and this one
+// is the day of the current week
+func Weekday(s rules.Strategy, w *WeekdayWords) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"(?:(?:" + w.Articles + ")\\s+)?" +
+			"(?:(?:(" + w.Next + ")|(" + w.Last + ")|(" + w.This + "))\\s+)?" +
+			"(" + w.Pattern + ")" +
+			"(?:\\s+(?:(" + w.NextAfter + ")|(" + w.LastAfter + ")))?" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			dayInt, ok := w.Offsets[strings.ToLower(m.Captures[3])]
+			if !ok {
+				return false, nil
+			}
+			c.Weekday = pointer.ToInt(dayInt)
+
+			diff := dayInt - int(ref.Weekday())
+			switch {
+			case m.Captures[1] != "" || m.Captures[5] != "":
+				if diff >= 0 {
+					diff -= 7
+				}
+			case m.Captures[2] != "":
+				// count from the first day of the week
+				start := int(w.WeekStart)
+				diff = (dayInt-start+7)%7 - (int(ref.Weekday())-start+7)%7
+			default:
+				if diff <= 0 {
+					diff += 7
+				}
+			}
+
+			c.Duration = time.Duration(diff) * 24 * time.Hour
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -18,7 +18,7 @@ func CasualTime(s rules.Strategy) rules.Rule {
 
 			lower := strings.ToLower(strings.TrimSpace(m.String()))
 
-			if (c.Hour != nil || c.Minute != nil) && s == rules.Override {
+			if c.Hour != nil || c.Minute != nil {
 				return false, nil
 			}
 
@@ -55,5 +55,6 @@ func CasualTime(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
 	"github.com/pkg/errors"
 )
 
@@ -24,13 +25,9 @@ func Hour(s rules.Strategy) rules.Rule {
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)(?:\\W|^)" +
 			"(" + INTEGER_WORDS_PATTERN + "|\\d{1,2})" +
-			"(?:\\s*час(?:а|ов|ам)?)?(?:\\s*(утра|вечера|дня))" +
+			"(?:\\s*час(?:а|ов|ам)?)?(?:\\s*(утра|вечера|дня|ночи))" +
 			"(?:\\P{L}|$)"),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if c.Hour != nil && s != rules.Override {
-				return false, nil
-			}
-
 			var hour int
 			var err error
 
@@ -51,6 +48,10 @@ func Hour(s rules.Strategy) rules.Rule {
 			switch m.Captures[1] {
 			case "утра":
 				c.Hour = &hour
+			case "ночи":
+				// "12 ночи" is midnight, "11 ночи" is 23:00
+				hour = common.Night.Clock(hour, o)
+				c.Hour = &hour
 			case "вечера", "дня":
 				if hour < 12 {
 					hour += 12
@@ -61,5 +62,6 @@ func Hour(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
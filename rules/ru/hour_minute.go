@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
 	"github.com/pkg/errors"
 )
 
@@ -35,13 +36,9 @@ func HourMinute(s rules.Strategy) rules.Rule {
 			"((?:[0-1]{0,1}[0-9])|(?:2[0-3]))" +
 			"(?:\\:|：|\\-|\\.)" +
 			"((?:[0-5][0-9]))" +
-			"(?:\\s*(утра|вечера|дня))?" +
+			"(?:\\s*(утра|вечера|дня|ночи))?" +
 			"(?:\\s|\\D|\\z)"),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if (c.Hour != nil || c.Minute != nil) && s != rules.Override {
-				return false, nil
-			}
-
 			hour, err := strconv.Atoi(m.Captures[0])
 			if err != nil {
 				return false, errors.Wrap(err, "hour minute rule")
@@ -61,6 +58,9 @@ func HourMinute(s rules.Strategy) rules.Rule {
 				switch m.Captures[2] {
 				case "утра": // am
 					c.Hour = &hour
+				case "ночи": // night, 12 is midnight and 7-11 are pm
+					hour = common.Night.Clock(hour, o)
+					c.Hour = &hour
 				case "вечера", "дня": // pm
 					if hour < 12 {
 						hour += 12
@@ -73,5 +73,6 @@ func HourMinute(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
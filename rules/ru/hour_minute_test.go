@@ -20,6 +20,8 @@ func TestHourMinute(t *testing.T) {
 		{"в 5-59 вечера", 3, "5-59 вечера", (17 * time.Hour) + (59 * time.Minute)},
 		{"в 17-59 вечерело", 3, "17-59", (17 * time.Hour) + (59 * time.Minute)},
 		{"до 11.10 вечера", 5, "11.10 вечера", (23 * time.Hour) + (10 * time.Minute)},
+		{"в 11:30 ночи", 3, "11:30 ночи", (23 * time.Hour) + (30 * time.Minute)},
+		{"в 2:15 ночи", 3, "2:15 ночи", (2 * time.Hour) + (15 * time.Minute)},
 	}
 
 	fixtnil := []Fixture{
@@ -0,0 +1,99 @@
+package ru
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+)
+
+/*
+	"без четверти восемь" -> 7:45
+	"без десяти 8 вечера" -> 19:50
+	"половина восьмого", "полвосьмого" -> 7:30
+	"четверть третьего" -> 2:15
+	"десять минут третьего" -> 2:10
+
+	An ordinal like "восьмого" names the hour in progress, so it is the
+	eighth hour starting at 7:00.
+*/
+
+func HourRelative(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:[^\\p{L}\\p{N}]|^)" +
+			"(?:" +
+			"(без)\\s+(" + MINUTE_WORDS_PATTERN + "|[0-9]{1,2})(?:\\s+минут\\p{L}*)?\\s+(" + INTEGER_WORDS_PATTERN + "|[0-9]{1,2})" +
+			"|(половин[аеуы]|пол)\\s*(" + HOUR_ORDINALS_PATTERN + ")" +
+			"|(" + MINUTE_WORDS_PATTERN + "|[0-9]{1,2})(?:\\s+минут\\p{L}*)?\\s+(" + HOUR_ORDINALS_PATTERN + ")" +
+			")" +
+			"(?:\\s+(утра|вечера|дня|ночи))?" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			var hour, minutes, before int
+			var ok bool
+			switch {
+			case m.Captures[0] != "":
+				before, ok = parseMinutes(m.Captures[1])
+				if !ok || before >= 60 {
+					return false, nil
+				}
+				hour, ok = INTEGER_WORDS[strings.ToLower(m.Captures[2])]
+				if !ok {
+					hour, _ = strconv.Atoi(m.Captures[2])
+				}
+				hour--
+				minutes = 60 - before
+			case m.Captures[3] != "":
+				hour = HOUR_ORDINALS[strings.ToLower(m.Captures[4])] - 1
+				minutes = 30
+			default:
+				minutes, ok = parseMinutes(m.Captures[5])
+				if !ok || minutes >= 60 {
+					return false, nil
+				}
+				hour = HOUR_ORDINALS[strings.ToLower(m.Captures[6])] - 1
+			}
+
+			if hour < 0 || hour > 12 {
+				return false, nil
+			}
+			if hour == 0 {
+				hour = 12
+			}
+
+			switch strings.ToLower(m.Captures[7]) {
+			case "утра":
+				if hour == 12 {
+					hour = 0
+				}
+			case "ночи":
+				hour = common.Night.Clock(hour, o)
+			case "вечера", "дня":
+				if hour < 12 {
+					hour += 12
+				}
+			}
+
+			zero := 0
+			c.Hour = &hour
+			c.Minute = &minutes
+			c.Second = &zero
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
+
+// parseMinutes converts the minutes said before an hour to their value
+func parseMinutes(s string) (int, bool) {
+	s = strings.Join(strings.Fields(strings.ToLower(s)), " ")
+	if n, ok := MINUTE_WORDS[s]; ok {
+		return n, true
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil
+}
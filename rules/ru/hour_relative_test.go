@@ -0,0 +1,36 @@
+package ru_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ru"
+)
+
+func TestHourRelative(t *testing.T) {
+	fixt := []Fixture{
+		{"без четверти восемь", 0, "без четверти восемь", 7*time.Hour + 45*time.Minute},
+		{"без десяти 8 вечера", 0, "без десяти 8 вечера", 19*time.Hour + 50*time.Minute},
+		{"без 5 минут час", 0, "без 5 минут час", 12*time.Hour + 55*time.Minute},
+		{"половина восьмого", 0, "половина восьмого", 7*time.Hour + 30*time.Minute},
+		{"встретимся в полвосьмого", 24, "полвосьмого", 7*time.Hour + 30*time.Minute},
+		{"четверть третьего дня", 0, "четверть третьего дня", 14*time.Hour + 15*time.Minute},
+		{"десять минут третьего", 0, "десять минут третьего", 2*time.Hour + 10*time.Minute},
+		{"половина первого ночи", 0, "половина первого ночи", 24*time.Hour + 30*time.Minute},
+		{"половина одиннадцатого ночи", 0, "половина одиннадцатого ночи", 22*time.Hour + 30*time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Add(ru.HourRelative(rules.Override))
+
+	ApplyFixtures(t, "ru.HourRelative", w, fixt)
+
+	fixtnil := []Fixture{
+		{"без 75 минут восемь", 0, "", 0},
+		{"третьего января", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "ru.HourRelative nil", w, fixtnil)
+}
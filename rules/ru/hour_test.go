@@ -18,6 +18,11 @@ func TestHour(t *testing.T) {
 		{"в час дня", 3, "час дня", 13 * time.Hour},
 		{"в одиннадцать часов утра", 3, "одиннадцать часов утра", 11 * time.Hour},
 		{"в семь вечера", 3, "семь вечера", 19 * time.Hour},
+		{"в 11 ночи", 3, "11 ночи", 23 * time.Hour},
+		{"в 10 ночи", 3, "10 ночи", 22 * time.Hour},
+		{"в 3 ночи", 3, "3 ночи", 3 * time.Hour},
+		{"в 12 ночи", 3, "12 ночи", 24 * time.Hour},
+		{"в 6 ночи", 3, "6 ночи", 6 * time.Hour},
 	}
 
 	w := when.New(nil)
@@ -0,0 +1,18 @@
+package ru
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"5 минут назад"
+	"час назад"
+	"полчаса назад"
+	"два дня тому назад"
+	"несколько месяцев назад"
+*/
+
+func PastTime(s rules.Strategy) rules.Rule {
+	return slavic.PastTime(s, `(?:тому\s+)?назад`, RELATIVE_WORDS)
+}
@@ -0,0 +1,34 @@
+package ru_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ru"
+)
+
+func TestPastTime(t *testing.T) {
+	fixt := []Fixture{
+		{"5 минут назад", 0, "5 минут назад", -(5 * time.Minute)},
+		{"это было полчаса назад", 16, "полчаса назад", -(time.Hour / 2)},
+		{"час назад", 0, "час назад", -(time.Hour)},
+		{"два дня тому назад", 0, "два дня тому назад", -(2 * 24 * time.Hour)},
+		{"пару недель назад", 0, "пару недель назад", -(14 * 24 * time.Hour)},
+		{"месяц назад", 0, "месяц назад", -(31 * 24 * time.Hour)},
+		{"несколько месяцев назад", 0, "несколько месяцев назад", -(92 * 24 * time.Hour)},
+		{"год назад", 0, "год назад", -(365 * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(ru.PastTime(rules.Skip))
+
+	ApplyFixtures(t, "ru.PastTime", w, fixt)
+
+	fixtnil := []Fixture{
+		{"назад", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "ru.PastTime nil", w, fixtnil)
+}
@@ -0,0 +1,20 @@
+package ru
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"на следующей неделе" -> 9:00 on the next Monday
+	"на прошлой неделе" -> -7 days
+	"в понедельник на следующей неделе" -> Monday of the next week
+	"в следующем месяце" -> the first day of the next month
+	"в прошлом месяце" -> the previous month
+	"в этом году" -> the current year
+	"в позапрошлом году" -> two years back
+*/
+
+func RelativeWeek(s rules.Strategy) rules.Rule {
+	return slavic.RelativeWeek(s, PERIOD_WORDS)
+}
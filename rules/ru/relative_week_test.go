@@ -0,0 +1,28 @@
+package ru_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ru"
+)
+
+func TestRelativeWeek(t *testing.T) {
+	fixt := []Fixture{
+		{"на следующей неделе", 0, "на следующей неделе", (5*24 + 9) * time.Hour},
+		{"сделаю на прошлой неделе", 13, "на прошлой неделе", -(7 * 24 * time.Hour)},
+		{"на этой неделе", 0, "на этой неделе", 0},
+		{"в следующем месяце", 0, "в следующем месяце", (26*24 + 9) * time.Hour},
+		{"в прошлом месяце", 0, "в прошлом месяце", -(31 * 24 * time.Hour)},
+		{"в следующем году", 0, "в следующем году", 366 * 24 * time.Hour},
+		{"в позапрошлом году", 0, "в позапрошлом году", -((365 + 365) * 24 * time.Hour)},
+		{"в этом году", 0, "в этом году", 0},
+	}
+
+	w := when.New(nil)
+	w.Add(ru.RelativeWeek(rules.Override))
+
+	ApplyFixtures(t, "ru.RelativeWeek", w, fixt)
+}
@@ -1,89 +1,204 @@
 package ru
 
 import (
-	"github.com/olebedev/when/rules"
+	_ "embed"
+	"regexp"
 	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/olebedev/when/rules/locale"
+	"github.com/olebedev/when/rules/romance"
+	"github.com/olebedev/when/rules/slavic"
 )
 
-var All = []rules.Rule{
-	Weekday(rules.Override),
-	CasualDate(rules.Override),
-	CasualTime(rules.Override),
-	Hour(rules.Override),
-	HourMinute(rules.Override),
-	Deadline(rules.Override),
-	Date(rules.Override),
-	DotDateTime(rules.Override),
+var describe = rules.Describer("ru")
+
+//go:embed ru.json
+var vocabulary []byte
+
+// DATA holds the words of the language, see Extend
+var DATA = locale.MustLoad(vocabulary)
+
+var All []rules.Rule
+
+// the patterns of the tables, see build
+var (
+	WEEKDAY_OFFSET_PATTERN string
+	INTEGER_WORDS_PATTERN  string
+	MONTHS_PATTERN         string
+)
+
+func init() {
+	build()
+}
+
+// Extend adds the words of ext to the language and rebuilds All, the
+// parsers made before keep the rules they have. Call it before making the
+// parsers, it isn't safe for concurrent use.
+func Extend(ext *locale.Data) {
+	DATA.Extend(ext)
+	build()
+}
+
+// build makes the patterns of the tables and the rules using them
+func build() {
+	WEEKDAY_OFFSET_PATTERN = locale.Pattern(WEEKDAY_OFFSET)
+	INTEGER_WORDS_PATTERN = locale.Pattern(INTEGER_WORDS)
+	MONTHS_PATTERN = locale.Pattern(DATA.Months)
+
+	MONTHS = make(map[string]time.Month, len(DATA.Months))
+	for w, n := range DATA.Months {
+		MONTHS[w] = time.Month(n)
+	}
+
+	All = []rules.Rule{
+		describe("weekday", rules.Date, 70, Weekday(rules.Override)),
+		describe("relative_week", rules.Range, 70, RelativeWeek(rules.Override)),
+		describe("casual_date", rules.Date, 60, CasualDate(rules.Override)),
+		describe("casual_time", rules.Time, 60, CasualTime(rules.Override)),
+		describe("midnight", rules.Time, 60, Midnight(rules.Override)),
+		describe("military_time", rules.Time, 50, common.MilitaryTime(rules.Override)),
+		describe("hour", rules.Time, 50, Hour(rules.Override)),
+		describe("hour_minute", rules.Time, 50, HourMinute(rules.Override)),
+		describe("hour_relative", rules.Time, 50, HourRelative(rules.Override)),
+		describe("deadline", rules.Duration, 40, Deadline(rules.Override)),
+		describe("past_time", rules.Duration, 40, PastTime(rules.Override)),
+		describe("date", rules.Date, 30, Date(rules.Override)),
+		describe("dot_date_time", rules.Date, 30, DotDateTime(rules.Override)),
+	}
 }
 
-var WEEKDAY_OFFSET = map[string]int{
-	"воскресенье":  0,
-	"воскресенья":  0,
-	"воск":         0,
-	"понедельник":  1,
-	"понедельнику": 1,
-	"понедельника": 1,
-	"пн":           1,
-	"вторник":      2,
-	"вторника":     2,
-	"вторнику":     2,
-	"вт":           2,
-	"среда":        3,
-	"среду":        3,
-	"среде":        3,
-	"ср":           3,
-	"четверг":      4,
-	"четверга":     4,
-	"четвергу":     4,
-	"чт":           4,
-	"пятница":      5,
-	"пятнице":      5,
-	"пятницы":      5,
-	"пятницу":      5,
-	"пт":           5,
-	"суббота":      6,
-	"субботы":      6,
-	"субботе":      6,
-	"субботу":      6,
-	"сб":           6,
+// CONNECTORS holds the words joining the parts of a time, like "в" of
+// "завтра, после встречи, в 15:00", see rules.ClusterByTokens
+var CONNECTORS = []string{"в", "во", "на", "к", "около", "с", "до"}
+
+// LABELS are the words labeling the numbers of the things, like "комната" of
+// "комната 1200", see STOPS
+var LABELS = []string{
+	"комната", "комнате", "комнату", "комнаты", "кабинет", "кабинете",
+	"аудитория", "аудитории", "версия", "версии", "номер", "заказ", "рейс",
+	"страница", "страницу", "странице", "глава", "главу", "квартира", "квартире",
 }
 
-var WEEKDAY_OFFSET_PATTERN = "(?:воскресенье|воскресенья|воск|понедельник|понедельнику|понедельника|пн|вторник|вторника|вторнику|вт|среда|среду|среде|ср|четверг|четверга|четвергу|чт|пятница|пятнице|пятницы|пятницу|пт|суббота|субботы|субботе|субботу|сб)"
-
-var INTEGER_WORDS = map[string]int{
-	"час":         1,
-	"один":        1,
-	"одну":        1,
-	"одного":      1,
-	"два":         2,
-	"две":         2,
-	"три":         3,
-	"четыре":      4,
-	"пять":        5,
-	"шесть":       6,
-	"семь":        7,
-	"восемь":      8,
-	"девять":      9,
-	"десять":      10,
-	"одиннадцать": 11,
-	"двенадцать":  12,
+// STOPS keep the numbers of the things from being read as a time or a date,
+// like "комната 1200" or "версия 2.0", see Parser.Stop of the when package
+var STOPS = []*regexp.Regexp{
+	rules.Labeled(LABELS...),
 }
 
-var INTEGER_WORDS_PATTERN = `(?:час|один|одну|одного|два|две|три|четыре|пять|шесть|семь|восемь|девять|десять|одиннадцать|двенадцать)`
-
-var MONTHS = map[string]time.Month{
-	"января":   time.January,
-	"февраля":  time.February,
-	"марта":    time.March,
-	"апреля":   time.April,
-	"мая":      time.May,
-	"июня":     time.June,
-	"июля":     time.July,
-	"августа":  time.August,
-	"сентября": time.September,
-	"октября":  time.October,
-	"ноября":   time.November,
-	"декабря":  time.December,
+// the tables of the language, from ru.json
+var (
+	WEEKDAY_OFFSET = DATA.Weekdays
+	INTEGER_WORDS  = DATA.Numerals
+)
+
+// MONTHS holds the months of DATA as time.Month, see build
+var MONTHS map[string]time.Month
+
+// HOUR_ORDINALS holds the genitive ordinals naming the hour in progress, like
+// "восьмого" in "половина восьмого", 7:30
+var HOUR_ORDINALS = map[string]int{
+	"первого":       1,
+	"второго":       2,
+	"третьего":      3,
+	"четвёртого":    4,
+	"четвертого":    4,
+	"пятого":        5,
+	"шестого":       6,
+	"седьмого":      7,
+	"восьмого":      8,
+	"девятого":      9,
+	"десятого":      10,
+	"одиннадцатого": 11,
+	"двенадцатого":  12,
 }
 
-var MONTHS_PATTERN = `(?:января|февраля|марта|апреля|мая|июня|июля|августа|сентября|октября|ноября|декабря)`
+var HOUR_ORDINALS_PATTERN = locale.Pattern(HOUR_ORDINALS)
+
+// MINUTE_WORDS holds the minutes said before an hour, in the nominative
+// case for "десять минут третьего" and in the genitive one for "без десяти
+// три"
+var MINUTE_WORDS = map[string]int{
+	"пять":          5,
+	"пяти":          5,
+	"десять":        10,
+	"десяти":        10,
+	"четверть":      15,
+	"четверти":      15,
+	"пятнадцать":    15,
+	"пятнадцати":    15,
+	"двадцать":      20,
+	"двадцати":      20,
+	"двадцать пять": 25,
+	"двадцати пяти": 25,
+}
+
+var MINUTE_WORDS_PATTERN = locale.Pattern(MINUTE_WORDS)
+
+// RELATIVE_WORDS holds the words of the deadlines and the past times, the
+// amounts in all the forms they agree with their unit in
+var RELATIVE_WORDS = &slavic.Relative{
+	Amounts: map[string]float64{
+		"один":        1,
+		"одну":        1,
+		"одного":      1,
+		"одной":       1,
+		"два":         2,
+		"две":         2,
+		"двух":        2,
+		"пару":        2,
+		"три":         3,
+		"трёх":        3,
+		"трех":        3,
+		"несколько":   3,
+		"нескольких":  3,
+		"четыре":      4,
+		"четырёх":     4,
+		"четырех":     4,
+		"пять":        5,
+		"пяти":        5,
+		"шесть":       6,
+		"семь":        7,
+		"восемь":      8,
+		"девять":      9,
+		"десять":      10,
+		"одиннадцать": 11,
+		"двенадцать":  12,
+		"пятнадцать":  15,
+		"двадцать":    20,
+		"тридцать":    30,
+		"пол":         0.5,
+		"полу":        0.5,
+		"полтора":     1.5,
+		"полторы":     1.5,
+	},
+	Units: map[string]romance.Unit{
+		"секунд": romance.Seconds,
+		"минут":  romance.Minutes,
+		"час":    romance.Hours,
+		"день":   romance.Days,
+		"дн":     romance.Days,
+		"недел":  romance.Weeks,
+		"месяц":  romance.Months,
+		"год":    romance.Years,
+		"лет":    romance.Years,
+	},
+}
+
+// PERIOD_WORDS holds the words of the relative weeks, months and years
+var PERIOD_WORDS = &slavic.PeriodWords{
+	Periods: map[string]common.Period{
+		"недел": common.Week,
+		"месяц": common.Month,
+		"год":   common.Year,
+	},
+	Shifts: map[string]int{
+		"следующ":   1,
+		"будущ":     1,
+		"прошл":     -1,
+		"позапрошл": -2,
+		"эт":        0,
+	},
+	Prepositions: []string{"на", "в", "во"},
+}
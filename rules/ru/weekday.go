@@ -35,10 +35,6 @@ func Weekday(s rules.Strategy) rules.Rule {
 				return false, nil
 			}
 
-			if c.Duration != 0 && s != rules.Override {
-				return false, nil
-			}
-
 			// Switch:
 			switch {
 			case strings.Contains(norm, "прошл") || strings.Contains(norm, "последн"):
@@ -86,7 +82,22 @@ func Weekday(s rules.Strategy) rules.Rule {
 				}
 			}
 
+			// the day of the week named after it, the weeks start on monday
+			if week := strings.ToLower(m.Captures[2]); week != "" {
+				shift := 0
+				switch week {
+				case "следующей":
+					shift = 1
+				case "прошлой":
+					shift = -1
+				}
+				from := (int(ref.Weekday()) + 6) % 7
+				to := (dayInt + 6) % 7
+				c.Duration = time.Duration(shift*7+to-from) * 24 * time.Hour
+			}
+
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
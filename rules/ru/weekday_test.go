@@ -31,6 +31,11 @@ func TestWeekday(t *testing.T) {
 		{"эта суббота", 0, "эта суббота", 3 * 24 * time.Hour},
 		{"во вторник", 0, "во вторник", 6 * 24 * time.Hour},
 		{"в субботу", 0, "в субботу", 3 * 24 * time.Hour},
+
+		// the day of the week named after it
+		{"в пятницу на следующей неделе", 0, "в пятницу на следующей", 9 * 24 * time.Hour},
+		{"в понедельник на прошлой неделе", 0, "в понедельник на прошлой", -(9 * 24 * time.Hour)},
+		{"в среду на этой неделе", 0, "в среду на этой", 0},
 	}
 
 	w := when.New(nil)
@@ -2,14 +2,24 @@ package rules
 
 import (
 	"regexp"
+	"sort"
 	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
+// Strategy tells what a rule does to the fields of the context set by the
+// rules applied before it, see Match.Apply
 type Strategy int
 
 const (
+	// Skip keeps the first value of a field, the rule sets only the fields
+	// not set yet
 	Skip Strategy = iota
+	// Merge adds the duration of the rule to the one set already and keeps
+	// the first value of the other fields as Skip does
 	Merge
+	// Override keeps the last value of a field, the one of the rule
 	Override
 )
 
@@ -17,13 +27,62 @@ type Rule interface {
 	Find(string) *Match
 }
 
+// Finder is a rule finding all its matches in the text, not the first one
+// only
+type Finder interface {
+	Rule
+	FindAll(string) []*Match
+}
+
+// FindAll returns all the matches of the rule in the text, the first one
+// only if the rule is not a Finder
+func FindAll(r Rule, text string) []*Match {
+	if f, ok := r.(Finder); ok {
+		return f.FindAll(text)
+	}
+	if m := r.Find(text); m != nil {
+		return []*Match{m}
+	}
+	return nil
+}
+
 type Options struct {
 	Afternoon, Evening, Morning, Noon int
 
 	Distance int
 
+	// Clustering defines how the matches are put together, by Distance by
+	// default. Tokens is the number of words allowed between the matches
+	// with ClusterByTokens, the connectors of the parser not counted.
+	Clustering Clustering
+	Tokens     int
+
 	MatchByOrder bool
 
+	// Hemisphere and SeasonMapping define the boundaries the season rules
+	// resolve to, northern meteorological seasons by default
+	Hemisphere    Hemisphere
+	SeasonMapping SeasonMapping
+
+	// Midnight defines the day a midnight attached to it refers to, the end
+	// of the day by default: "midnight friday" is 00:00 on Saturday
+	Midnight MidnightConvention
+
+	// Meridiem defines how an hour said without am or pm is read, as it is
+	// by default. WorkStart and WorkEnd are the working hours it infers
+	// them by, from 8 to 18 if not set.
+	Meridiem           MeridiemPolicy
+	WorkStart, WorkEnd int
+
+	// Alternatives makes the parser report the other readings of an
+	// ambiguous text along with the one it picked, see Context.Ambiguous
+	Alternatives bool
+
+	// Strict makes the parser return an error rather than a time for a text
+	// naming no date, naming it twice differently or ambiguously, see
+	// ErrInvalidDate, ErrContradiction and ErrAmbiguous
+	Strict bool
+
 	// TODO
 	// WeekStartsOn time.Weekday
 }
@@ -33,27 +92,120 @@ type Match struct {
 	Text        string
 	Captures    []string
 	Order       float64
+	Strategy    Strategy
 	Applier     func(*Match, *Context, *Options, time.Time) (bool, error)
+
+	// Fields are the names of the fields of the context the match set once
+	// applied, in the order Duration, Year, Month, Weekday, Day, Hour,
+	// Minute, Second and Location
+	Fields []string
+
+	// Bounds are the borders of each of the Captures in the text, -1 for
+	// the ones that did not match
+	Bounds [][2]int
 }
 
 func (m Match) String() string { return m.Text }
 
+// Apply applies the match to the context by its strategy. The applier
+// works on a copy of the context and the fields it set are merged back
+// and listed in Fields, a match the applier refuses leaves the context as
+// is.
 func (m *Match) Apply(c *Context, o *Options, t time.Time) (bool, error) {
-	return m.Applier(m, c, o, t)
+	next := c.Copy()
+	if m.Strategy == Merge {
+		// the duration of the rule alone, to add up
+		next.Duration = 0
+	}
+	prev := next
+
+	ok, err := m.Applier(m, &next, o, t)
+	if err != nil || !ok {
+		return ok, err
+	}
+
+	m.Fields = c.merge(&next, &prev, m.Strategy)
+	return true, nil
 }
 
+// F is a rule of a regexp and an applier. A rule made without a Strategy
+// skips the fields set already, Skip being the zero value, so a rule
+// meant to override them says so.
 type F struct {
-	RegExp  *regexp.Regexp
-	Applier func(*Match, *Context, *Options, time.Time) (bool, error)
+	RegExp   *regexp.Regexp
+	Applier  func(*Match, *Context, *Options, time.Time) (bool, error)
+	Strategy Strategy
 }
 
 func (f *F) Find(text string) *Match {
-	m := &Match{
-		Applier: f.Applier,
-		Left:    -1,
+	return f.match(text, f.RegExp.FindStringSubmatchIndex(text))
+}
+
+// FindAll returns all the matches of the regexp sorted by index, the ones
+// starting at a word inside another match too, like "march 5th" of "2 march
+// 5th", so the parser can pick the one not overlapping a better match
+func (f *F) FindAll(text string) []*Match {
+	var matches []*Match
+	add := func(indexes []int) {
+		m := f.match(text, indexes)
+		if m == nil {
+			return
+		}
+		for _, o := range matches {
+			if o.Left == m.Left && o.Right == m.Right {
+				return
+			}
+		}
+		matches = append(matches, m)
 	}
 
-	indexes := f.RegExp.FindStringSubmatchIndex(text)
+	for _, indexes := range f.RegExp.FindAllStringSubmatchIndex(text, -1) {
+		add(indexes)
+		for i := indexes[0] + 1; i < indexes[1]; i++ {
+			if !wordStart(text, i) {
+				continue
+			}
+			inner := f.RegExp.FindStringSubmatchIndex(text[i:])
+			if inner == nil || i+inner[0] >= indexes[1] {
+				continue
+			}
+			for j := range inner {
+				if inner[j] >= 0 {
+					inner[j] += i
+				}
+			}
+			add(inner)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Left < matches[j].Left
+	})
+	return matches
+}
+
+// wordStart tells if a word starts at the byte i of the text
+func wordStart(text string, i int) bool {
+	if !utf8.RuneStart(text[i]) {
+		return false
+	}
+	prev, _ := utf8.DecodeLastRuneInString(text[:i])
+	next, _ := utf8.DecodeRuneInString(text[i:])
+	return !isWord(prev) && isWord(next)
+}
+
+func isWord(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// match makes a match of the indexes of the captures, nil if none of them
+// matched
+func (f *F) match(text string, indexes []int) *Match {
+	m := &Match{
+		Applier:  f.Applier,
+		Strategy: f.Strategy,
+		Left:     -1,
+	}
 
 	length := len(indexes)
 	if length <= 2 {
@@ -72,6 +224,7 @@ func (f *F) Find(text string) *Match {
 		} else {
 			m.Captures = append(m.Captures, "")
 		}
+		m.Bounds = append(m.Bounds, [2]int{indexes[i], indexes[i+1]})
 	}
 
 	if len(m.Captures) == 0 || m.Left == -1 {
@@ -0,0 +1,86 @@
+package rules
+
+import "time"
+
+type Season int
+
+const (
+	Spring Season = iota
+	Summer
+	Autumn
+	Winter
+)
+
+type Hemisphere int
+
+const (
+	Northern Hemisphere = iota
+	Southern
+)
+
+type SeasonMapping int
+
+const (
+	// Meteorological seasons start on the first day of March, June,
+	// September and December
+	Meteorological SeasonMapping = iota
+	// Astronomical seasons start on the equinoxes and solstices, around
+	// the 20th-22nd of the same months
+	Astronomical
+)
+
+// astronomical start days of the seasons by their start month
+var astronomicalStartDays = map[time.Month]int{
+	time.March:     20,
+	time.June:      21,
+	time.September: 22,
+	time.December:  21,
+}
+
+// SeasonStart returns the first day of the season starting in the given year
+// according to the hemisphere and mapping set in the options. Southern seasons
+// are shifted by half a year, so the southern summer of 2016 starts in
+// December 2016.
+func (o *Options) SeasonStart(s Season, year int) time.Time {
+	month := time.Month(3 + 3*int(s))
+	if o != nil && o.Hemisphere == Southern {
+		month = (month+5)%12 + 1
+	}
+
+	day := 1
+	if o != nil && o.SeasonMapping == Astronomical {
+		day = astronomicalStartDays[month]
+	}
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// SeasonOccurrence returns the start of the season occurrence relative to ref:
+// 0 is the current season if it's ongoing or the upcoming one otherwise, 1 is
+// the first one starting after ref and -1 the last one that has already ended.
+func (o *Options) SeasonOccurrence(s Season, offset int, ref time.Time) time.Time {
+	ref = time.Date(ref.Year(), ref.Month(), ref.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch {
+	case offset < 0:
+		start := o.SeasonStart(s, ref.Year())
+		for start.AddDate(0, 3, 0).After(ref) {
+			start = o.SeasonStart(s, start.Year()-1)
+		}
+		return start
+	case offset == 0:
+		if prev := o.SeasonStart(s, ref.Year()-1); prev.AddDate(0, 3, 0).After(ref) {
+			return prev
+		}
+		if start := o.SeasonStart(s, ref.Year()); start.AddDate(0, 3, 0).After(ref) {
+			return start
+		}
+		return o.SeasonStart(s, ref.Year()+1)
+	default:
+		start := o.SeasonStart(s, ref.Year())
+		if !start.After(ref) {
+			start = o.SeasonStart(s, ref.Year()+1)
+		}
+		return start
+	}
+}
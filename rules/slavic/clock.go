@@ -0,0 +1,201 @@
+package slavic
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/pkg/errors"
+)
+
+// ClockWords holds the words of the times of a language
+type ClockWords struct {
+	// At precedes an hour, like "о" or "o", an hour said after it needs
+	// neither its unit nor the part of the day
+	At []string
+
+	// Hours are the stems of the unit following an hour, like "годин"
+	Hours []string
+
+	// Ordinals maps the ordinals naming the hours to their value, like
+	// "дев'ятій" in "о дев'ятій" or "ósmej" in "o ósmej"
+	Ordinals map[string]int
+
+	// Parts maps the words of the parts of the day following a time to
+	// them, like "вечора" or "po południu"
+	Parts map[string]common.DayPart
+}
+
+// PartsPattern returns the pattern of the words of the parts of the day
+func (w *ClockWords) PartsPattern() string {
+	parts := make([]string, 0, len(w.Parts))
+	for k := range w.Parts {
+		parts = append(parts, k)
+	}
+	return Words(parts...)
+}
+
+// Part returns the part of the day of the word
+func (w *ClockWords) Part(word string) (common.DayPart, bool) {
+	p, ok := w.Parts[normalize(word)]
+	return p, ok
+}
+
+// hour converts a digit or ordinal hour of the captures to a 24-hour
+// clock with the part of the day captured after it, if any
+func (w *ClockWords) hour(number, part string, o *rules.Options) (int, bool, error) {
+	hour, ok := Lookup(number, w.Ordinals)
+	if !ok {
+		var err error
+		hour, err = strconv.Atoi(number)
+		if err != nil {
+			return 0, false, err
+		}
+	}
+
+	if part != "" {
+		p, ok := w.Part(part)
+		if !ok || hour > 12 {
+			return 0, false, nil
+		}
+		return p.Clock(hour, o), true, nil
+	}
+	return hour, hour < 24, nil
+}
+
+/*
+	"о 9", "o 9" -> 9:00
+	"о дев'ятій", "o dziewiątej" -> 9:00
+	"5 вечора", "o 5 po południu" -> 17:00
+*/
+
+// Hour builds a rule for the hours said after the words of At or before
+// a part of the day
+func Hour(s rules.Strategy, w *ClockWords) rules.Rule {
+	number := "[0-9]{1,2}|" + Words(Keys(w.Ordinals)...)
+	unit := "(?:\\s*(" + Stems(w.Hours...) + "))?"
+
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:[^\\p{L}\\p{N}]|^)" +
+			"(?:(" + Words(w.At...) + ")\\s+(" + number + ")" + unit + "(?:\\s+(" + w.PartsPattern() + "))?" +
+			"|(" + number + ")" + unit + "\\s+(" + w.PartsPattern() + "))" +
+			"(?:[^\\p{L}\\p{N}]|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			hour, ok, err := w.hour(m.Captures[1]+m.Captures[4], m.Captures[3]+m.Captures[6], o)
+			if err != nil {
+				return false, errors.Wrap(err, "hour rule")
+			}
+			if !ok {
+				return false, nil
+			}
+
+			c.Hour = &hour
+			c.Minute = pointer.ToInt(0)
+			c.Second = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
+
+/*
+	"17:30", "о 17:30", "o 17:30" -> 17:30
+	"5:30 вечора" -> 17:30
+*/
+
+// HourMinute builds a rule for the times of a 24-hour clock
+func HourMinute(s rules.Strategy, w *ClockWords) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:[^\\p{L}\\p{N}]|^)" +
+			"(?:(" + Words(w.At...) + ")\\s+)?" +
+			"([01]?[0-9]|2[0-3])[:：]([0-5][0-9])" +
+			"(?:\\s+(" + w.PartsPattern() + "))?" +
+			"(?:[^\\p{L}\\p{N}]|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			hour, ok, err := w.hour(m.Captures[1], m.Captures[3], o)
+			if err != nil {
+				return false, errors.Wrap(err, "hour minute rule")
+			}
+			if !ok {
+				return false, nil
+			}
+
+			minutes, err := strconv.Atoi(m.Captures[2])
+			if err != nil {
+				return false, errors.Wrap(err, "hour minute rule")
+			}
+
+			c.Hour = &hour
+			c.Minute = &minutes
+			c.Second = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
+
+/*
+	"ввечері", "wieczorem" -> 18:00
+	"вранці", "rano" -> 8:00
+	"після обіду", "po południu" -> 15:00
+*/
+
+// CasualTime builds a rule for the parts of the day said without an hour,
+// parts maps their words to them
+func CasualTime(s rules.Strategy, parts map[string]common.DayPart) rules.Rule {
+	w := &ClockWords{Parts: parts}
+
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"(" + w.PartsPattern() + ")" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			p, ok := w.Part(m.Captures[0])
+			if !ok {
+				return false, nil
+			}
+
+			c.Hour = pointer.ToInt(p.Hour(o))
+			c.Minute = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
+
+/*
+	"опівночі", "o północy" -> the end of today
+	"опівдні", "w południe" -> 12:00
+
+	A midnight belongs to the end of its day unless Options.Midnight is set
+	to rules.MidnightStartOfDay.
+*/
+
+// Midnight builds a rule for the midnight and the noon
+func Midnight(s rules.Strategy, midnight, noon []string) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"(?:(" + Words(midnight...) + ")|(" + Words(noon...) + "))" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			if m.Captures[0] != "" {
+				c.Hour = pointer.ToInt(o.MidnightHour())
+			} else if o.Noon != 0 {
+				c.Hour = &o.Noon
+			} else {
+				c.Hour = pointer.ToInt(12)
+			}
+			c.Minute = pointer.ToInt(0)
+			c.Second = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,94 @@
+package slavic
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/pkg/errors"
+)
+
+/*
+	"сьогодні", "dziś" -> today
+	"завтра", "jutro" -> tomorrow
+	"позавчора", "przedwczoraj" -> the day before yesterday
+*/
+
+// CasualDate builds a rule for the days said relative to today, days maps
+// their words to the number of days from today
+func CasualDate(s rules.Strategy, days map[string]int) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"(" + Words(Keys(days)...) + ")" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			n, ok := Lookup(m.Captures[0], days)
+			if !ok {
+				return false, nil
+			}
+
+			c.Duration = time.Duration(n) * 24 * time.Hour
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
+
+// MonthWords holds the words of the dates of a language
+type MonthWords struct {
+	// Months maps the stems of the months to their number, like "берез" or
+	// "marc"
+	Months map[string]int
+
+	// Year follows the year, like "року" or "r.", optional
+	Year []string
+}
+
+/*
+	"5 березня", "5 marca" -> March 5
+	"5 березня 2017 року", "5 marca 2017 r." -> March 5, 2017
+*/
+
+// MonthDate builds a rule for the dates with a day before the month and
+// an optional year after it
+func MonthDate(s rules.Strategy, w *MonthWords) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:[^\\p{L}\\p{N}]|^)" +
+			"([0-9]{1,2})\\s+" +
+			"(" + Stems(Keys(w.Months)...) + ")" +
+			"(?:\\s+([0-9]{4})(?:\\s*(" + Words(w.Year...) + "))?)?" +
+			"(?:[^\\p{L}\\p{N}]|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			month, ok := LookupStem(m.Captures[1], w.Months)
+			if !ok {
+				return false, nil
+			}
+
+			day, err := strconv.Atoi(m.Captures[0])
+			if err != nil {
+				return false, errors.Wrap(err, "month date rule")
+			}
+			if day < 1 || day > 31 {
+				return false, nil
+			}
+
+			if m.Captures[2] != "" {
+				year, err := strconv.Atoi(m.Captures[2])
+				if err != nil {
+					return false, errors.Wrap(err, "month date rule")
+				}
+				c.Year = &year
+				common.ForgetYear(c, year)
+			}
+
+			c.Day = &day
+			c.Month = &month
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
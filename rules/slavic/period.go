@@ -0,0 +1,68 @@
+package slavic
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+)
+
+// PeriodWords holds the words of the relative periods of a language
+type PeriodWords struct {
+	// Periods maps the stems of the periods to their kind, like "недел" or
+	// "miesi"
+	Periods map[string]common.Period
+
+	// Shifts maps the stems of the words before a period to the number of
+	// periods they go from the current one, like "следующ" to 1 or "zeszł"
+	// to -1
+	Shifts map[string]int
+
+	// Prepositions precede the words, like "на" or "w", optional
+	Prepositions []string
+}
+
+/*
+	"на следующей неделе", "наступного тижня" -> 9:00 on the next Monday
+	"в прошлом месяце", "w zeszłym miesiącu" -> the previous month
+	"в этом году", "w tym roku" -> the current year
+*/
+
+// RelativeWeek builds a rule for the weeks, months and years relative to
+// the current ones, see common.ApplyPeriod for their meaning
+func RelativeWeek(s rules.Strategy, w *PeriodWords) rules.Rule {
+	periods := make([]string, 0, len(w.Periods))
+	for k := range w.Periods {
+		periods = append(periods, k)
+	}
+
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"(" + Words(w.Prepositions...) + "\\s+)?" +
+			"(" + Stems(Keys(w.Shifts)...) + ")\\s+" +
+			"(" + Stems(periods...) + ")" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			shift, ok := LookupStem(m.Captures[1], w.Shifts)
+			if !ok {
+				return false, nil
+			}
+
+			word, longest := normalize(m.Captures[2]), 0
+			var period common.Period
+			for stem, p := range w.Periods {
+				if len(stem) > longest && strings.HasPrefix(word, stem) {
+					period, longest = p, len(stem)
+				}
+			}
+			if longest == 0 {
+				return false, nil
+			}
+
+			return common.ApplyPeriod(c, ref, period, shift), nil
+		},
+		Strategy: s,
+	}
+}
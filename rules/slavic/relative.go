@@ -0,0 +1,84 @@
+package slavic
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/romance"
+	"github.com/pkg/errors"
+)
+
+// Relative holds the words of the relative expressions of a language
+type Relative struct {
+	// Amounts holds all the forms of the spelled-out amounts, like "одну",
+	// "две" or "несколько"
+	Amounts map[string]float64
+
+	// Units maps the stems of the unit words to their unit, like "недел" or
+	// "godzin"
+	Units map[string]romance.Unit
+}
+
+// pattern returns the pattern of an amount and its unit, capturing both,
+// the amount is optional and may stick to the unit as "пол" in "полчаса"
+func (r *Relative) pattern() string {
+	amounts := make([]string, 0, len(r.Amounts))
+	for k := range r.Amounts {
+		amounts = append(amounts, k)
+	}
+	units := make([]string, 0, len(r.Units))
+	for k := range r.Units {
+		units = append(units, k)
+	}
+
+	return "(?:(" + Words(amounts...) + "|[0-9]+(?:[.,][0-9]+)?)\\s*)?" +
+		"(" + Stems(units...) + ")"
+}
+
+// apply sets the context to the amount and unit of the captures
+func (r *Relative) apply(captures []string, sign float64, c *rules.Context, ref time.Time) (bool, error) {
+	amount, err := ParseAmount(captures[0], r.Amounts)
+	if err != nil {
+		return false, err
+	}
+
+	unit, ok := romance.LookupUnit(normalize(captures[1]), r.Units)
+	if !ok {
+		return false, nil
+	}
+
+	romance.ApplyAmount(c, ref, sign*amount, unit)
+	return true, nil
+}
+
+// Deadline builds a rule for the expressions of the time to come like
+// "через 3 дня" or "za godzinę", prefix matches the words before the
+// amount
+func Deadline(s rules.Strategy, prefix string, r *Relative) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"((?:" + prefix + ")\\s+" + r.pattern() + ")" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			ok, err := r.apply(m.Captures[1:], 1, c, ref)
+			return ok, errors.Wrap(err, "deadline rule")
+		},
+		Strategy: s,
+	}
+}
+
+// PastTime builds a rule for the expressions of the past like "5 минут
+// назад" or "3 dni temu", suffix matches the words after the unit
+func PastTime(s rules.Strategy, suffix string, r *Relative) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:[^\\p{L}\\p{N}]|^)" +
+			"(" + r.pattern() + "\\s+(" + suffix + "))" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			ok, err := r.apply(m.Captures[1:], -1, c, ref)
+			return ok, errors.Wrap(err, "past time rule")
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,96 @@
+// Package slavic holds the helpers and rule builders the Slavic languages
+// share. Their words change the ending with the case, the gender and the
+// number, "неделя", "недели", "неделю", so the tables of the builders hold
+// the stems of the words and match whatever ending follows them. A numeral
+// agrees with the noun it counts, "одна минута", "один час", "две недели",
+// so the tables of the amounts list all the forms of a numeral instead.
+package slavic
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/olebedev/when/rules/locale"
+	"github.com/olebedev/when/rules/romance"
+)
+
+// apostrophes are the ways to write the apostrophe of words like "п'ять"
+var apostrophes = strings.NewReplacer("’", "'", "ʼ", "'")
+
+// Words returns the pattern of the words, whatever apostrophe they are
+// written with, the longer words go first
+func Words(words ...string) string {
+	return strings.Replace(locale.ListPattern(words), "'", "['’ʼ]", -1)
+}
+
+// Stems returns the pattern of the words starting with one of the stems
+func Stems(stems ...string) string {
+	return Words(stems...) + "\\p{L}*"
+}
+
+// Keys returns the words of the table
+func Keys(table map[string]int) []string {
+	keys := make([]string, 0, len(table))
+	for k := range table {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// normalize lowercases the word and collapses its spaces and apostrophes
+func normalize(word string) string {
+	return apostrophes.Replace(strings.ToLower(strings.Join(strings.Fields(word), " ")))
+}
+
+// Lookup returns the value of the word in the table
+func Lookup(word string, table map[string]int) (int, bool) {
+	n, ok := table[normalize(word)]
+	return n, ok
+}
+
+// LookupStem returns the value of the longest stem of the table the word
+// starts with
+func LookupStem(word string, stems map[string]int) (int, bool) {
+	word = normalize(word)
+
+	value, longest := 0, 0
+	for stem, v := range stems {
+		if len(stem) > longest && strings.HasPrefix(word, stem) {
+			value, longest = v, len(stem)
+		}
+	}
+	return value, longest > 0
+}
+
+// Decline returns the forms of the stems with each of the endings, like
+// "восьма", "восьму" and "восьмої" of "восьм" with "а", "у" and "ої"
+func Decline(stems map[string]int, endings ...string) map[string]int {
+	forms := make(map[string]int, len(stems)*len(endings))
+	for stem, v := range stems {
+		for _, ending := range endings {
+			forms[stem+ending] = v
+		}
+	}
+	return forms
+}
+
+// Merge returns the words of all the tables, the later tables win
+func Merge(tables ...map[string]int) map[string]int {
+	merged := make(map[string]int)
+	for _, t := range tables {
+		for k, v := range t {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+// ParseAmount converts the amount counting a unit to its value, a unit
+// said on its own is one of it, like "через час" or "za godzinę"
+func ParseAmount(s string, amounts map[string]float64) (float64, error) {
+	if strings.TrimSpace(s) == "" {
+		return 1, nil
+	}
+	return romance.ParseAmount(apostrophes.Replace(s), amounts)
+}
@@ -0,0 +1,62 @@
+package slavic
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+)
+
+// WeekdayWords holds the words of the weekday expressions of a language
+type WeekdayWords struct {
+	// Offsets maps all the forms of the weekdays to their offset from
+	// Sunday, like "понеділок" and "понеділка", see Decline
+	Offsets map[string]int
+
+	// Prepositions precede a weekday without changing it, like "у" or "w"
+	Prepositions []string
+
+	// Next, Last and This are the stems of the words preceding a weekday,
+	// like "наступн", "zeszł" or "ць"
+	Next, Last, This []string
+}
+
+// Weekday builds a rule for the weekdays, a weekday on its own and the
+// next one are the upcoming day, the last one is the past day and this one
+// is the day of the current week, weeks start on Monday
+func Weekday(s rules.Strategy, w *WeekdayWords) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:\\P{L}|^)" +
+			"(" + Words(w.Prepositions...) + "\\s+)?" +
+			"(?:(?:(" + Stems(w.Next...) + ")|(" + Stems(w.Last...) + ")|(" + Stems(w.This...) + "))\\s+)?" +
+			"(" + Words(Keys(w.Offsets)...) + ")" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			dayInt, ok := Lookup(m.Captures[4], w.Offsets)
+			if !ok {
+				return false, nil
+			}
+			c.Weekday = pointer.ToInt(dayInt)
+
+			diff := dayInt - int(ref.Weekday())
+			switch {
+			case m.Captures[2] != "":
+				if diff >= 0 {
+					diff -= 7
+				}
+			case m.Captures[3] != "":
+				// count from Monday
+				diff = (dayInt+6)%7 - (int(ref.Weekday())+6)%7
+			default:
+				if diff <= 0 {
+					diff += 7
+				}
+			}
+
+			c.Duration = time.Duration(diff) * 24 * time.Hour
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
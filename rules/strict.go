@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The errors of the strict mode, see Options.Strict. They come wrapped
+// with the details, errors.Cause tells which one it is.
+var (
+	// ErrInvalidDate is for a text naming no date, like "February 30" or
+	// "at 25"
+	ErrInvalidDate = errors.New("invalid date")
+	// ErrContradiction is for a text naming a date twice, differently, like
+	// "Monday March 5" when March 5 is a Tuesday
+	ErrContradiction = errors.New("contradictory date")
+	// ErrAmbiguous is for a text that could be read to another time, like
+	// "at 7 o'clock" or "3/4", see Context.Ambiguous
+	ErrAmbiguous = errors.New("ambiguous date")
+)
+
+// IsLeap tells if the year of the Gregorian calendar is a leap one
+func IsLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// DaysIn returns the number of days in the month of the year
+func DaysIn(year, month int) int {
+	switch time.Month(month) {
+	case time.February:
+		if IsLeap(year) {
+			return 29
+		}
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	}
+	return 31
+}
+
+// Invalid refuses a match reading to no date, like the hour of "at 25".
+// It returns ErrInvalidDate with the message in strict mode and no error
+// otherwise, so that the parser just skips the match.
+func (o *Options) Invalid(format string, args ...interface{}) (bool, error) {
+	if o == nil || !o.Strict {
+		return false, nil
+	}
+	return false, errors.Wrapf(ErrInvalidDate, format, args...)
+}
+
+// Check tells if the context reads to the time t it is bound to as it is.
+// It returns ErrInvalidDate if t does not keep a value of the context,
+// like the 30th of February moved on to the 1st of March, and
+// ErrContradiction if the weekday of t is not the one of the context.
+func (c *Context) Check(t time.Time) error {
+	// midnight, hour 24, is the 00:00 of the next day
+	day, hour := t, t.Hour()
+	if c.Hour != nil && *c.Hour == 24 && hour == 0 {
+		day, hour = t.AddDate(0, 0, -1), 24
+	}
+
+	names := []string{"year", "month", "weekday", "day", "hour", "minute", "second"}
+	got := []int{day.Year(), int(day.Month()), int(day.Weekday()), day.Day(), hour, t.Minute(), t.Second()}
+	// the smallest value goes first, it moves the greater ones on
+	fields := c.fields()
+	for i := len(fields) - 1; i >= 0; i-- {
+		if f := *fields[i]; f != nil && *f != got[i] && names[i] != "weekday" {
+			return errors.Wrapf(ErrInvalidDate, "%s %d", names[i], *f)
+		}
+	}
+
+	if c.Weekday != nil && *c.Weekday != int(day.Weekday()) {
+		return errors.Wrapf(ErrContradiction, "%s is not %s",
+			day.Format("January 2, 2006"), time.Weekday(*c.Weekday))
+	}
+
+	return nil
+}
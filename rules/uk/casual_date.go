@@ -0,0 +1,26 @@
+package uk
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"сьогодні", "зараз" -> today
+	"завтра" -> tomorrow
+	"післязавтра" -> the day after tomorrow
+	"вчора", "учора" -> yesterday
+	"позавчора" -> the day before yesterday
+*/
+
+func CasualDate(s rules.Strategy) rules.Rule {
+	return slavic.CasualDate(s, map[string]int{
+		"сьогодні":    0,
+		"зараз":       0,
+		"завтра":      1,
+		"післязавтра": 2,
+		"вчора":       -1,
+		"учора":       -1,
+		"позавчора":   -2,
+	})
+}
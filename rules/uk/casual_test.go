@@ -0,0 +1,71 @@
+package uk_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/uk"
+)
+
+func TestCasualDate(t *testing.T) {
+	fixt := []Fixture{
+		{"зараз", 0, "зараз", 0},
+		{"сьогодні", 0, "сьогодні", 0},
+		{"завтра", 0, "завтра", 24 * time.Hour},
+		{"післязавтра", 0, "післязавтра", 2 * 24 * time.Hour},
+		{"вчора", 0, "вчора", -(24 * time.Hour)},
+		{"учора", 0, "учора", -(24 * time.Hour)},
+		{"позавчора", 0, "позавчора", -(2 * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(uk.CasualDate(rules.Skip))
+
+	ApplyFixtures(t, "uk.CasualDate", w, fixt)
+}
+
+func TestCasualTime(t *testing.T) {
+	fixt := []Fixture{
+		{"вранці", 0, "вранці", 8 * time.Hour},
+		{"зранку", 0, "зранку", 8 * time.Hour},
+		{"після обіду", 0, "після обіду", 15 * time.Hour},
+		{"ввечері", 0, "ввечері", 18 * time.Hour},
+		{"вночі", 0, "вночі", 23 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(uk.CasualTime(rules.Skip))
+
+	ApplyFixtures(t, "uk.CasualTime", w, fixt)
+}
+
+func TestCasualDateCasualTime(t *testing.T) {
+	fixt := []Fixture{
+		{"завтра вранці", 0, "завтра вранці", (24 + 8) * time.Hour},
+		{"вчора ввечері", 0, "вчора ввечері", (18 - 24) * time.Hour},
+		{"сьогодні після обіду", 0, "сьогодні після обіду", 15 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(
+		uk.CasualDate(rules.Skip),
+		uk.CasualTime(rules.Override),
+	)
+
+	ApplyFixtures(t, "uk.CasualDate|uk.CasualTime", w, fixt)
+}
+
+func TestMidnight(t *testing.T) {
+	fixt := []Fixture{
+		{"опівночі", 0, "опівночі", 24 * time.Hour},
+		{"о півночі", 0, "о півночі", 24 * time.Hour},
+		{"опівдні", 0, "опівдні", 12 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(uk.Midnight(rules.Override))
+
+	ApplyFixtures(t, "uk.Midnight", w, fixt)
+}
@@ -0,0 +1,29 @@
+package uk
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"вранці", "зранку" -> 8:00
+	"після обіду" -> 15:00
+	"ввечері", "увечері" -> 18:00
+	"вночі" -> 23:00
+*/
+
+func CasualTime(s rules.Strategy) rules.Rule {
+	return slavic.CasualTime(s, map[string]common.DayPart{
+		"вранці":      common.Morning,
+		"уранці":      common.Morning,
+		"зранку":      common.Morning,
+		"після обіду": common.Afternoon,
+		"по обіді":    common.Afternoon,
+		"ввечері":     common.Evening,
+		"увечері":     common.Evening,
+		"вечором":     common.Evening,
+		"вночі":       common.Night,
+		"уночі":       common.Night,
+	})
+}
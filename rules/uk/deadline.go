@@ -0,0 +1,17 @@
+package uk
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"через 3 дні"
+	"за п'ять хвилин"
+	"протягом години"
+	"через півгодини"
+*/
+
+func Deadline(s rules.Strategy) rules.Rule {
+	return slavic.Deadline(s, `через|за|протягом|впродовж`, RELATIVE_WORDS)
+}
@@ -0,0 +1,31 @@
+package uk_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/uk"
+)
+
+func TestDeadline(t *testing.T) {
+	fixt := []Fixture{
+		{"через 3 дні", 0, "через 3 дні", 3 * 24 * time.Hour},
+		{"за п'ять хвилин", 0, "за п'ять хвилин", 5 * time.Minute},
+		{"протягом години", 0, "протягом години", time.Hour},
+		{"через півгодини", 0, "через півгодини", 30 * time.Minute},
+		{"через півтори години", 0, "через півтори години", 90 * time.Minute},
+		{"через 5 секунд", 0, "через 5 секунд", 5 * time.Second},
+		{"через тиждень", 0, "через тиждень", 7 * 24 * time.Hour},
+		{"за два тижні", 0, "за два тижні", 14 * 24 * time.Hour},
+		{"через місяць", 0, "через місяць", 31 * 24 * time.Hour},
+		{"за рік", 0, "за рік", 366 * 24 * time.Hour},
+		{"через добу", 0, "через добу", 24 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(uk.Deadline(rules.Skip))
+
+	ApplyFixtures(t, "uk.Deadline", w, fixt)
+}
@@ -0,0 +1,17 @@
+package uk
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"о 9", "о 9 годині" -> 9:00
+	"о дев'ятій" -> 9:00
+	"5 вечора", "о п'ятій вечора" -> 17:00
+	"12 ночі" -> 0:00
+*/
+
+func Hour(s rules.Strategy) rules.Rule {
+	return slavic.Hour(s, CLOCK_WORDS)
+}
@@ -0,0 +1,15 @@
+package uk
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"17:30", "о 17:30" -> 17:30
+	"5:30 вечора" -> 17:30
+*/
+
+func HourMinute(s rules.Strategy) rules.Rule {
+	return slavic.HourMinute(s, CLOCK_WORDS)
+}
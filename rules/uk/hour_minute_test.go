@@ -0,0 +1,24 @@
+package uk_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/uk"
+)
+
+func TestHourMinute(t *testing.T) {
+	fixt := []Fixture{
+		{"17:30", 0, "17:30", 17*time.Hour + 30*time.Minute},
+		{"о 17:30", 0, "о 17:30", 17*time.Hour + 30*time.Minute},
+		{"5:30 вечора", 0, "5:30 вечора", 17*time.Hour + 30*time.Minute},
+		{"о 9:05 ранку", 0, "о 9:05 ранку", 9*time.Hour + 5*time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Add(uk.HourMinute(rules.Override))
+
+	ApplyFixtures(t, "uk.HourMinute", w, fixt)
+}
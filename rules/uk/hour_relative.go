@@ -0,0 +1,85 @@
+package uk
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"пів на восьму", "пів восьмої" -> 7:30
+	"чверть на восьму" -> 7:15
+	"десять хвилин на восьму" -> 7:10
+	"за чверть восьма" -> 7:45
+	"за десять восьма" -> 7:50
+	"пів на восьму вечора" -> 19:30
+
+	An ordinal like "восьма" names the hour in progress, so it is the
+	eighth hour starting at 7:00.
+*/
+
+func HourRelative(s rules.Strategy) rules.Rule {
+	ordinals := slavic.Words(slavic.Keys(HOUR_ORDINALS)...)
+	minutes := slavic.Words(slavic.Keys(MINUTE_WORDS)...) + "|[0-9]{1,2}"
+
+	return &rules.F{
+		RegExp: regexp.MustCompile("(?i)(?:[^\\p{L}\\p{N}]|^)" +
+			"(?:(о|об)\\s+)?" +
+			"(?:(пів)\\s+(?:на\\s+)?(" + ordinals + ")" +
+			"|(" + minutes + ")(?:\\s+хвилин\\p{L}*)?\\s+на\\s+(" + ordinals + ")" +
+			"|(за)\\s+(" + minutes + ")\\s+(" + ordinals + "))" +
+			"(?:\\s+(" + CLOCK_WORDS.PartsPattern() + "))?" +
+			"(?:\\P{L}|$)"),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			var ordinal, minutes string
+			switch {
+			case m.Captures[1] != "":
+				ordinal = m.Captures[2]
+			case m.Captures[3] != "":
+				ordinal, minutes = m.Captures[4], m.Captures[3]
+			default:
+				ordinal, minutes = m.Captures[7], m.Captures[6]
+			}
+
+			hour, ok := slavic.Lookup(ordinal, HOUR_ORDINALS)
+			if !ok {
+				return false, nil
+			}
+			hour--
+			if hour == 0 {
+				hour = 12
+			}
+
+			n := 30
+			if minutes != "" {
+				if n, ok = slavic.Lookup(minutes, MINUTE_WORDS); !ok {
+					n, _ = strconv.Atoi(minutes)
+				}
+				if n <= 0 || n >= 60 {
+					return false, nil
+				}
+				if m.Captures[5] != "" {
+					n = 60 - n
+				}
+			}
+
+			if part, ok := CLOCK_WORDS.Part(m.Captures[8]); ok {
+				if hour > 12 {
+					return false, nil
+				}
+				hour = part.Clock(hour, o)
+			}
+
+			c.Hour = &hour
+			c.Minute = &n
+			c.Second = pointer.ToInt(0)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
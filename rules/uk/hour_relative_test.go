@@ -0,0 +1,37 @@
+package uk_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/uk"
+)
+
+func TestHourRelative(t *testing.T) {
+	fixt := []Fixture{
+		{"пів на восьму", 0, "пів на восьму", 7*time.Hour + 30*time.Minute},
+		{"пів восьмої", 0, "пів восьмої", 7*time.Hour + 30*time.Minute},
+		{"зустрінемось о пів на дев'яту", 25, "о пів на дев'яту", 8*time.Hour + 30*time.Minute},
+		{"чверть на восьму", 0, "чверть на восьму", 7*time.Hour + 15*time.Minute},
+		{"десять хвилин на восьму", 0, "десять хвилин на восьму", 7*time.Hour + 10*time.Minute},
+		{"за чверть восьма", 0, "за чверть восьма", 7*time.Hour + 45*time.Minute},
+		{"за десять восьма", 0, "за десять восьма", 7*time.Hour + 50*time.Minute},
+		{"пів на восьму вечора", 0, "пів на восьму вечора", 19*time.Hour + 30*time.Minute},
+		{"пів на першу", 0, "пів на першу", 12*time.Hour + 30*time.Minute},
+		{"пів на першу ночі", 0, "пів на першу ночі", 24*time.Hour + 30*time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Add(uk.HourRelative(rules.Override))
+
+	ApplyFixtures(t, "uk.HourRelative", w, fixt)
+
+	fixtnil := []Fixture{
+		{"за 75 восьма", 0, "", 0},
+		{"восьма", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "uk.HourRelative nil", w, fixtnil)
+}
@@ -0,0 +1,38 @@
+package uk_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/uk"
+)
+
+func TestHour(t *testing.T) {
+	fixt := []Fixture{
+		{"о 9", 0, "о 9", 9 * time.Hour},
+		{"о 9 годині", 0, "о 9 годині", 9 * time.Hour},
+		{"об 11", 0, "об 11", 11 * time.Hour},
+		{"о 21", 0, "о 21", 21 * time.Hour},
+		{"о дев'ятій", 0, "о дев'ятій", 9 * time.Hour},
+		{"о п'ятій вечора", 0, "о п'ятій вечора", 17 * time.Hour},
+		{"5 вечора", 0, "5 вечора", 17 * time.Hour},
+		{"о восьмій ранку", 0, "о восьмій ранку", 8 * time.Hour},
+		{"12 ночі", 0, "12 ночі", 24 * time.Hour},
+		{"2 дня", 0, "2 дня", 14 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(uk.Hour(rules.Override))
+
+	ApplyFixtures(t, "uk.Hour", w, fixt)
+
+	fixtnil := []Fixture{
+		{"5 хвилин", 0, "", 0},
+		{"дев'ятій", 0, "", 0},
+		{"о 25", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "uk.Hour nil", w, fixtnil)
+}
@@ -0,0 +1,20 @@
+package uk
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"опівночі", "о півночі" -> the end of today
+	"у п'ятницю опівночі" -> the end of friday, 00:00 on saturday
+	"завтра опівдні" -> 12:00 tomorrow
+*/
+
+// Midnight handles midnight and noon
+func Midnight(s rules.Strategy) rules.Rule {
+	return slavic.Midnight(s,
+		[]string{"опівночі", "о півночі", "північ"},
+		[]string{"опівдні", "о полудні", "полудень"},
+	)
+}
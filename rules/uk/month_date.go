@@ -0,0 +1,15 @@
+package uk
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"5 березня" -> March 5
+	"5 березня 2017 року" -> March 5, 2017
+*/
+
+func MonthDate(s rules.Strategy) rules.Rule {
+	return slavic.MonthDate(s, MONTH_WORDS)
+}
@@ -0,0 +1,31 @@
+package uk_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/uk"
+)
+
+func TestMonthDate(t *testing.T) {
+	fixt := []Fixture{
+		{"5 березня", 0, "5 березня", time.Date(2016, 3, 5, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"31 грудня", 0, "31 грудня", time.Date(2016, 12, 31, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"5 березня 2017", 0, "5 березня 2017", time.Date(2017, 3, 5, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"5 березня 2017 року", 0, "5 березня 2017 року", time.Date(2017, 3, 5, 0, 0, 0, 0, time.UTC).Sub(null)},
+		{"1 січня 2017 р.", 0, "1 січня 2017 р.", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC).Sub(null)},
+	}
+
+	w := when.New(nil)
+	w.Add(uk.MonthDate(rules.Override))
+
+	ApplyFixtures(t, "uk.MonthDate", w, fixt)
+
+	fixtnil := []Fixture{
+		{"35 березня", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "uk.MonthDate nil", w, fixtnil)
+}
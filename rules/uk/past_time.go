@@ -0,0 +1,17 @@
+package uk
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"5 хвилин тому"
+	"годину тому"
+	"два дні тому"
+	"кілька місяців тому"
+*/
+
+func PastTime(s rules.Strategy) rules.Rule {
+	return slavic.PastTime(s, `тому`, RELATIVE_WORDS)
+}
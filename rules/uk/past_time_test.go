@@ -0,0 +1,34 @@
+package uk_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/uk"
+)
+
+func TestPastTime(t *testing.T) {
+	fixt := []Fixture{
+		{"5 хвилин тому", 0, "5 хвилин тому", -(5 * time.Minute)},
+		{"це було 5 хвилин тому", 14, "5 хвилин тому", -(5 * time.Minute)},
+		{"годину тому", 0, "годину тому", -(time.Hour)},
+		{"пів години тому", 0, "пів години тому", -(time.Hour / 2)},
+		{"два дні тому", 0, "два дні тому", -(2 * 24 * time.Hour)},
+		{"тиждень тому", 0, "тиждень тому", -(7 * 24 * time.Hour)},
+		{"кілька місяців тому", 0, "кілька місяців тому", -(92 * 24 * time.Hour)},
+		{"рік тому", 0, "рік тому", -(365 * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(uk.PastTime(rules.Skip))
+
+	ApplyFixtures(t, "uk.PastTime", w, fixt)
+
+	fixtnil := []Fixture{
+		{"тому", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "uk.PastTime nil", w, fixtnil)
+}
@@ -0,0 +1,16 @@
+package uk
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"наступного тижня", "на наступному тижні" -> 9:00 on the next Monday
+	"минулого місяця" -> the previous month
+	"цього року" -> the current year
+*/
+
+func RelativeWeek(s rules.Strategy) rules.Rule {
+	return slavic.RelativeWeek(s, PERIOD_WORDS)
+}
@@ -0,0 +1,28 @@
+package uk_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/uk"
+)
+
+func TestRelativeWeek(t *testing.T) {
+	fixt := []Fixture{
+		{"наступного тижня", 0, "наступного тижня", (5*24 + 9) * time.Hour},
+		{"на минулому тижні", 0, "на минулому тижні", -(7 * 24 * time.Hour)},
+		{"цього тижня", 0, "цього тижня", 0},
+		{"наступного місяця", 0, "наступного місяця", (26*24 + 9) * time.Hour},
+		{"минулого місяця", 0, "минулого місяця", -(31 * 24 * time.Hour)},
+		{"наступного року", 0, "наступного року", 366 * 24 * time.Hour},
+		{"позаминулого року", 0, "позаминулого року", -((365 + 365) * 24 * time.Hour)},
+		{"в цьому році", 0, "в цьому році", 0},
+	}
+
+	w := when.New(nil)
+	w.Add(uk.RelativeWeek(rules.Override))
+
+	ApplyFixtures(t, "uk.RelativeWeek", w, fixt)
+}
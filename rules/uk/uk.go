@@ -0,0 +1,210 @@
+package uk
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+	"github.com/olebedev/when/rules/romance"
+	"github.com/olebedev/when/rules/slavic"
+	"regexp"
+)
+
+var describe = rules.Describer("uk")
+
+var All = []rules.Rule{
+	describe("weekday", rules.Date, 70, Weekday(rules.Override)),
+	describe("relative_week", rules.Range, 70, RelativeWeek(rules.Override)),
+	describe("casual_date", rules.Date, 60, CasualDate(rules.Override)),
+	describe("casual_time", rules.Time, 60, CasualTime(rules.Override)),
+	describe("midnight", rules.Time, 60, Midnight(rules.Override)),
+	describe("military_time", rules.Time, 50, common.MilitaryTime(rules.Override)),
+	describe("hour", rules.Time, 50, Hour(rules.Override)),
+	describe("hour_minute", rules.Time, 50, HourMinute(rules.Override)),
+	describe("hour_relative", rules.Time, 50, HourRelative(rules.Override)),
+	describe("deadline", rules.Duration, 40, Deadline(rules.Override)),
+	describe("past_time", rules.Duration, 40, PastTime(rules.Override)),
+	describe("month_date", rules.Date, 30, MonthDate(rules.Override)),
+}
+
+// CONNECTORS holds the words joining the parts of a time, like "о" of
+// "завтра, після зустрічі, о 15:00", see rules.ClusterByTokens
+var CONNECTORS = []string{"в", "у", "о", "об", "на", "до", "з", "близько"}
+
+// LABELS are the words labeling the numbers of the things, like "кімната" of
+// "кімната 1200", see STOPS
+var LABELS = []string{
+	"кімната", "кімнаті", "кімнату", "кабінет", "кабінеті", "аудиторія",
+	"аудиторії", "версія", "версії", "номер", "замовлення", "рейс", "сторінка",
+	"сторінку", "сторінці", "розділ", "квартира", "квартирі",
+}
+
+// STOPS keep the numbers of the things from being read as a time or a date,
+// like "кімната 1200" or "версія 2.0", see Parser.Stop of the when package
+var STOPS = []*regexp.Regexp{
+	rules.Labeled(LABELS...),
+}
+
+// WEEKDAY_OFFSET holds the forms of the weekdays in the cases they are
+// said in, "неділя", "у неділю", "до неділі"
+var WEEKDAY_OFFSET = slavic.Merge(
+	slavic.Decline(map[string]int{"неділ": 0, "п'ятниц": 5}, "я", "ю", "і"),
+	slavic.Decline(map[string]int{"понеділ": 1, "вівтор": 2}, "ок", "ка", "ку"),
+	slavic.Decline(map[string]int{"серед": 3, "субот": 6}, "а", "у", "и", "і"),
+	slavic.Decline(map[string]int{"четвер": 4}, "", "га", "гу"),
+)
+
+// MONTH_OFFSET holds the stems of the months, "березень", "5 березня"
+var MONTH_OFFSET = map[string]int{
+	"січ":      1,
+	"лют":      2,
+	"берез":    3,
+	"квіт":     4,
+	"трав":     5,
+	"черв":     6,
+	"лип":      7,
+	"серп":     8,
+	"верес":    9,
+	"жовт":     10,
+	"листопад": 11,
+	"груд":     12,
+}
+
+// HOUR_ORDINALS holds the feminine ordinals naming the hours, "восьма",
+// "на восьму", "восьмої" and "о восьмій"
+var HOUR_ORDINALS = slavic.Merge(
+	slavic.Decline(map[string]int{
+		"перш":         1,
+		"друг":         2,
+		"четверт":      4,
+		"п'ят":         5,
+		"шост":         6,
+		"сьом":         7,
+		"восьм":        8,
+		"дев'ят":       9,
+		"десят":        10,
+		"одинадцят":    11,
+		"дванадцят":    12,
+		"тринадцят":    13,
+		"чотирнадцят":  14,
+		"п'ятнадцят":   15,
+		"шістнадцят":   16,
+		"сімнадцят":    17,
+		"вісімнадцят":  18,
+		"дев'ятнадцят": 19,
+		"двадцят":      20,
+	}, "а", "у", "ої", "ій"),
+	slavic.Decline(map[string]int{"трет": 3}, "я", "ю", "ьої", "ій"),
+)
+
+// MINUTE_WORDS holds the minutes said around an hour, like "чверть" in
+// "чверть на восьму"
+var MINUTE_WORDS = map[string]int{
+	"п'ять":          5,
+	"десять":         10,
+	"чверть":         15,
+	"п'ятнадцять":    15,
+	"двадцять":       20,
+	"двадцять п'ять": 25,
+}
+
+var DAY_PARTS = map[string]common.DayPart{
+	"ранку":  common.Morning,
+	"зранку": common.Morning,
+	"дня":    common.Afternoon,
+	"вечора": common.Evening,
+	"ночі":   common.Night,
+}
+
+var CLOCK_WORDS = &slavic.ClockWords{
+	At:       []string{"о", "об"},
+	Hours:    []string{"годин"},
+	Ordinals: HOUR_ORDINALS,
+	Parts:    DAY_PARTS,
+}
+
+var WEEKDAY_WORDS = &slavic.WeekdayWords{
+	Offsets:      WEEKDAY_OFFSET,
+	Prepositions: []string{"у", "в", "до", "на"},
+	Next:         []string{"наступн"},
+	Last:         []string{"минул", "попередн", "останн"},
+	This:         []string{"ць", "цей", "ця", "цю", "ці"},
+}
+
+var MONTH_WORDS = &slavic.MonthWords{
+	Months: MONTH_OFFSET,
+	Year:   []string{"року", "р."},
+}
+
+// RELATIVE_WORDS holds the words of the deadlines and the past times, the
+// amounts in all the forms they agree with their unit in
+var RELATIVE_WORDS = &slavic.Relative{
+	Amounts: map[string]float64{
+		"один":        1,
+		"одна":        1,
+		"одну":        1,
+		"одного":      1,
+		"однієї":      1,
+		"два":         2,
+		"дві":         2,
+		"двох":        2,
+		"пару":        2,
+		"три":         3,
+		"трьох":       3,
+		"кілька":      3,
+		"декілька":    3,
+		"кількох":     3,
+		"чотири":      4,
+		"чотирьох":    4,
+		"п'ять":       5,
+		"п'яти":       5,
+		"шість":       6,
+		"сім":         7,
+		"вісім":       8,
+		"дев'ять":     9,
+		"десять":      10,
+		"одинадцять":  11,
+		"дванадцять":  12,
+		"п'ятнадцять": 15,
+		"двадцять":    20,
+		"тридцять":    30,
+		"пів":         0.5,
+		"півтора":     1.5,
+		"півтори":     1.5,
+	},
+	Units: map[string]romance.Unit{
+		"секунд": romance.Seconds,
+		"хвилин": romance.Minutes,
+		"годин":  romance.Hours,
+		"день":   romance.Days,
+		"дн":     romance.Days,
+		"доб":    romance.Days,
+		"тижд":   romance.Weeks,
+		"тижн":   romance.Weeks,
+		"місяц":  romance.Months,
+		"рік":    romance.Years,
+		"рок":    romance.Years,
+	},
+}
+
+var PERIOD_WORDS = &slavic.PeriodWords{
+	Periods: map[string]common.Period{
+		"тижд":  common.Week,
+		"тижн":  common.Week,
+		"місяц": common.Month,
+		"рік":   common.Year,
+		"рок":   common.Year,
+		"роц":   common.Year,
+	},
+	Shifts: map[string]int{
+		"наступн":   1,
+		"майбутн":   1,
+		"минул":     -1,
+		"попередн":  -1,
+		"позаминул": -2,
+		"ць":        0,
+		"цей":       0,
+		"ця":        0,
+		"цю":        0,
+		"ці":        0,
+	},
+	Prepositions: []string{"на", "в", "у"},
+}
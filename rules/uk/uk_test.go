@@ -0,0 +1,59 @@
+package uk_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules/uk"
+	"github.com/stretchr/testify/require"
+)
+
+var null = time.Date(2016, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+type Fixture struct {
+	Text   string
+	Index  int
+	Phrase string
+	Diff   time.Duration
+}
+
+func ApplyFixtures(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d - %s", name, i, f.Text)
+		require.NotNil(t, res, "[%s] res #%d - %s", name, i, f.Text)
+		require.Equal(t, f.Index, res.Index, "[%s] index #%d - %s", name, i, f.Text)
+		require.Equal(t, f.Phrase, res.Text, "[%s] text #%d - %s", name, i, f.Text)
+		require.Equal(t, f.Diff, res.Time.Sub(null), "[%s] diff #%d - %s", name, i, f.Text)
+	}
+}
+
+func ApplyFixturesNil(t *testing.T, name string, w *when.Parser, fixt []Fixture) {
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, null)
+		require.Nil(t, err, "[%s] err #%d", name, i)
+		require.Nil(t, res, "[%s] res #%d", name, i)
+	}
+}
+
+func TestAll(t *testing.T) {
+	w := when.New(nil)
+	w.Add(uk.All...)
+
+	// complex cases
+	fixt := []Fixture{
+		{"завтра о 9", 0, "завтра о 9", (24 + 9) * time.Hour},
+		{"нагадай через 3 дні", 15, "через 3 дні", 3 * 24 * time.Hour},
+		{"побачимось у п'ятницю", 21, "у п'ятницю", 2 * 24 * time.Hour},
+		{"у п'ятницю о 18:30", 0, "у п'ятницю о 18:30", (2*24+18)*time.Hour + 30*time.Minute},
+		{"наступного понеділка ввечері", 0, "наступного понеділка ввечері", (5*24 + 18) * time.Hour},
+		{"сьогодні ввечері", 0, "сьогодні ввечері", 18 * time.Hour},
+		{"післязавтра о дев'ятій ранку", 0, "післязавтра о дев'ятій ранку", (2*24 + 9) * time.Hour},
+		{"в понеділок на наступному тижні", 0, "в понеділок на наступному тижні", 5 * 24 * time.Hour},
+		{"завтра о пів на восьму вечора", 0, "завтра о пів на восьму вечора", (24+19)*time.Hour + 30*time.Minute},
+		{"зустріч 5 березня 2017 року о 10:00", 15, "5 березня 2017 року о 10:00", time.Date(2017, 3, 5, 10, 0, 0, 0, time.UTC).Sub(null)},
+	}
+
+	ApplyFixtures(t, "uk.All...", w, fixt)
+}
@@ -0,0 +1,17 @@
+package uk
+
+import (
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/slavic"
+)
+
+/*
+	"у п'ятницю", "в неділю" -> the upcoming day
+	"наступного понеділка" -> the upcoming Monday
+	"минулої середи" -> the past Wednesday
+	"цього четверга" -> Thursday of the current week
+*/
+
+func Weekday(s rules.Strategy) rules.Rule {
+	return slavic.Weekday(s, WEEKDAY_WORDS)
+}
@@ -0,0 +1,39 @@
+package uk_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/uk"
+)
+
+func TestWeekday(t *testing.T) {
+	// current is Wednesday
+	fixt := []Fixture{
+		{"у п'ятницю", 0, "у п'ятницю", 2 * 24 * time.Hour},
+		{"у п’ятницю", 0, "у п’ятницю", 2 * 24 * time.Hour},
+		{"в неділю", 0, "в неділю", 4 * 24 * time.Hour},
+		{"у середу", 0, "у середу", 7 * 24 * time.Hour},
+		{"до вівторка", 0, "до вівторка", 6 * 24 * time.Hour},
+		{"наступного понеділка", 0, "наступного понеділка", 5 * 24 * time.Hour},
+		{"наступну суботу", 0, "наступну суботу", 3 * 24 * time.Hour},
+		{"минулої середи", 0, "минулої середи", -(7 * 24 * time.Hour)},
+		{"минулого понеділка", 0, "минулого понеділка", -(2 * 24 * time.Hour)},
+		{"цього четверга", 0, "цього четверга", 24 * time.Hour},
+		{"цей понеділок", 0, "цей понеділок", -(2 * 24 * time.Hour)},
+	}
+
+	w := when.New(nil)
+	w.Add(uk.Weekday(rules.Override))
+
+	ApplyFixtures(t, "uk.Weekday", w, fixt)
+
+	fixtnil := []Fixture{
+		{"у середині тижня", 0, "", 0},
+		{"п'ятницями", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "uk.Weekday nil", w, fixtnil)
+}
@@ -17,18 +17,15 @@ func AfterTime(s rules.Strategy) rules.Rule {
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)" +
 			"((?:[0-9]{0,3}))?" +
-			"(" + INTEGER_WORDS_PATTERN[3:] + "?" + "\\s*" +
+			"(" + INTEGER_WORDS_PATTERN[3:] + "?" + "\\s*" + "(?:个\\s*)?" +
 			"(?:(分|分钟|小时|天|周|月)\\s*)" +
 			"(后)" +
 			"(?:\\W|$)",
 		),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if c.Hour != nil && s != rules.Override {
-				return false, nil
-			}
 			duration, _ := strconv.Atoi(m.Captures[0])
 
-			if d, exist := INTEGER_WORDS[compressStr(m.Captures[1])]; exist {
+			if d, exist := parseInteger(m.Captures[1]); exist {
 				duration = d
 			}
 			if m.Captures[1] == "半" && m.Captures[2] == "小时" {
@@ -46,10 +43,11 @@ func AfterTime(s rules.Strategy) rules.Rule {
 			case "周":
 				c.Duration = time.Hour * 24 * 7 * time.Duration(duration)
 			case "月":
-				_, _ = c.Time(time.Now().AddDate(0, duration, 0))
+				shiftMonths(c, ref, duration)
 			}
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
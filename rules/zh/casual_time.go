@@ -10,18 +10,12 @@ import (
 )
 
 func CasualTime(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile(`(?i)(?:\W|^)((今天)?\s*(早晨|下午|傍晚|中午|晚上))`),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
 
 			lower := strings.ToLower(strings.TrimSpace(m.String()))
 
-			if (c.Hour != nil || c.Minute != nil) && !overwrite {
-				return false, nil
-			}
-
 			switch {
 			case strings.Contains(lower, "晚上"):
 				if o.Evening != 0 {
@@ -62,5 +56,6 @@ func CasualTime(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
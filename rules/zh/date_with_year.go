@@ -0,0 +1,71 @@
+package zh
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/cjk"
+	"github.com/olebedev/when/rules/common"
+)
+
+/*
+	"2022年3月14日"
+	"二〇二二年三月十四号"
+	"2022年3月" -> the first day of the month
+	"2022/3/14", "2022-03-14", "2022.3.14"
+*/
+
+func DateWithYear(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("" +
+			"(?:" +
+			"([0-9]{4}|[〇零一二三四五六七八九]{4})\\s*年\\s*" +
+			"(1[0-2]|0?[1-9]|" + MON_WORDS_PATTERN + ")\\s*(月)" +
+			"(?:\\s*(3[01]|[12][0-9]|0?[1-9]|" + DAY_WORDS_PATTERN + ")\\s*(日|号))?" +
+			"|" +
+			"([0-9]{4})\\s*([-/.])\\s*(1[0-2]|0?[1-9])\\s*([-/.])\\s*(3[01]|[12][0-9]|0?[1-9])" +
+			")" +
+			"(?:[^0-9]|$)",
+		),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			yearStr, monStr, dayStr := m.Captures[0], m.Captures[1], m.Captures[3]
+			if m.Captures[5] != "" {
+				// the separators must be the same, "2022-03/14" is no date
+				if m.Captures[6] != m.Captures[8] {
+					return false, nil
+				}
+				yearStr, monStr, dayStr = m.Captures[5], m.Captures[7], m.Captures[9]
+			}
+
+			year, ok := cjk.ParseInteger(yearStr)
+			if !ok {
+				return false, nil
+			}
+
+			month, ok := cjk.ParseInteger(monStr)
+			if !ok || month < 1 || month > 12 {
+				return false, nil
+			}
+
+			day := 1
+			if dayStr != "" {
+				if day, ok = cjk.ParseInteger(dayStr); !ok {
+					day, _ = strconv.Atoi(dayStr)
+				}
+			}
+			if day < 1 || day > 31 {
+				return false, nil
+			}
+
+			c.Year = &year
+			c.Month = &month
+			c.Day = &day
+			common.ForgetYear(c, year)
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,33 @@
+package zh_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/zh"
+)
+
+func TestDateWithYear(t *testing.T) {
+	fixt := []Fixture{
+		{"2022年3月20日", 0, "2022年3月20日", 6 * 24 * time.Hour},
+		{"在二〇二二年三月二十号", 3, "二〇二二年三月二十号", 6 * 24 * time.Hour},
+		{"2021年12月", 0, "2021年12月", -(31 + 31 + 28 + 13) * 24 * time.Hour},
+		{"2022/3/20", 0, "2022/3/20", 6 * 24 * time.Hour},
+		{"2022-03-20", 0, "2022-03-20", 6 * 24 * time.Hour},
+		{"2022.3.20", 0, "2022.3.20", 6 * 24 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(zh.DateWithYear(rules.Override))
+
+	ApplyFixtures(t, "zh.DateWithYear", w, fixt)
+
+	fixtnil := []Fixture{
+		{"2022-03/20", 0, "", 0},
+		{"2022年13月", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "zh.DateWithYear nil", w, fixtnil)
+}
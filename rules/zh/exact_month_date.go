@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/cjk"
 )
 
 /*
@@ -13,8 +14,6 @@ import (
 */
 
 func ExactMonthDate(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile("" +
 			"(?:\\b|^)" + // can't use \W here due to Chinese characters
@@ -33,8 +32,6 @@ func ExactMonthDate(s rules.Strategy) rules.Rule {
 		),
 
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			_ = overwrite
-
 			// the default value of month is the current month, and the default
 			// value of day is the first day of the month, so that we can handle
 			// cases like "4月" (Apr 1st) and "12号" (12th this month)
@@ -47,24 +44,16 @@ func ExactMonthDate(s rules.Strategy) rules.Rule {
 			}
 
 			if m.Captures[2] != "" {
-				monInt, exist = MON_WORDS[compressStr(m.Captures[2])]
+				monInt, exist = cjk.ParseInteger(m.Captures[2])
 				if !exist {
-					mon, err := strconv.Atoi(m.Captures[2])
-					if err != nil {
-						return false, nil
-					}
-					monInt = mon
+					return false, nil
 				}
 			}
 
 			if m.Captures[4] != "" {
-				dayInt, exist = DAY_WORDS[compressStr(m.Captures[4])]
+				dayInt, exist = cjk.ParseInteger(m.Captures[4])
 				if !exist {
-					day, err := strconv.Atoi(m.Captures[4])
-					if err != nil {
-						return false, nil
-					}
-					dayInt = day
+					return false, nil
 				}
 			}
 
@@ -86,5 +75,6 @@ func ExactMonthDate(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
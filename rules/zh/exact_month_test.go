@@ -27,6 +27,7 @@ func TestExactMonthDate(t *testing.T) {
 		{"5月1日", 0, "5月1日", 1152 * time.Hour},
 		{"五月", 0, "五月", 1152 * time.Hour},
 		{"12号", 0, "12号", (-2 * 24) * time.Hour},
+		{"四月二十四日", 0, "四月二十四日", (41 * 24) * time.Hour},
 	}
 
 	w := when.New(nil)
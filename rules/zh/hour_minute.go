@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/cjk"
 )
 
 /*
@@ -26,16 +27,12 @@ func HourMinute(s rules.Strategy) rules.Rule {
 			"(?:(凌\\s*晨|早\\s*晨|早\\s*上|上\\s*午|下\\s*午|晚\\s*上|今晚)?\\s*)" +
 			"((?:[0-1]{0,1}[0-9])|(?:2[0-3]))?" + "(?:\\s*)" +
 			"(" + INTEGER_WORDS_PATTERN[3:] + "?" +
-			"(\\:|：|\\-|点)" +
+			"(\\:|：|点)" +
 			"((?:[0-5][0-9]))?" +
 			"(" + INTEGER_WORDS_PATTERN + "+)?" +
 			"(?:\\W|$)"),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if (c.Hour != nil || c.Minute != nil) && s != rules.Override {
-				return false, nil
-			}
-
-			hour, exist := INTEGER_WORDS[m.Captures[2]] // 中文
+			hour, exist := parseInteger(m.Captures[2]) // 中文
 			if !exist {
 				hour, _ = strconv.Atoi(m.Captures[1])
 			}
@@ -44,7 +41,7 @@ func HourMinute(s rules.Strategy) rules.Rule {
 				return false, nil
 			}
 
-			minutes, exist := INTEGER_WORDS[m.Captures[5]]
+			minutes, exist := parseInteger(m.Captures[5])
 			if !exist {
 				minutes, _ = strconv.Atoi(m.Captures[4])
 			}
@@ -54,13 +51,22 @@ func HourMinute(s rules.Strategy) rules.Rule {
 			}
 			c.Minute = &minutes
 
-			lower := compressStr(m.Captures[0])
+			lower := cjk.Compress(m.Captures[0])
 			switch lower {
-			case "上午", "凌晨", "早晨", "早上":
+			case "上午", "早晨", "早上":
+				c.Hour = &hour
+			case "凌晨":
+				// 凌晨12点 is the start of the day
+				if hour == 12 {
+					hour = 0
+				}
 				c.Hour = &hour
 			case "下午", "晚上", "今晚":
 				if hour < 12 {
 					hour += 12
+				} else if hour == 12 && lower != "下午" {
+					// 晚上12点 is the midnight at the end of the day
+					hour = 24
 				}
 				c.Hour = &hour
 			case "":
@@ -72,5 +78,6 @@ func HourMinute(s rules.Strategy) rules.Rule {
 			}
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
@@ -0,0 +1,77 @@
+package zh
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/cjk"
+)
+
+/*
+	"差一刻八点" -> 7:45
+	"八点差五分" -> 7:55
+	"差10分9点" -> 8:50
+	"晚上差一刻十点" -> 21:45
+*/
+
+func HourRelative(s rules.Strategy) rules.Rule {
+	minutes := "([0-9]{1,2}|一刻|" + cjk.NUMERAL_PATTERN + ")"
+	hour := "((?:[01]?[0-9]|2[0-3])|" + cjk.NUMERAL_PATTERN + ")"
+
+	return &rules.F{
+		RegExp: regexp.MustCompile("" +
+			"(?:(凌晨|早晨|早上|上午|下午|晚上|今晚)\\s*)?" +
+			"(?:" +
+			"(差)\\s*" + minutes + "\\s*(?:分钟|分)?\\s*" + hour + "\\s*(点)" +
+			"|" +
+			hour + "\\s*点\\s*差\\s*" + minutes + "(?:\\s*(分钟|分))?" +
+			")",
+		),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			minStr, hourStr := m.Captures[2], m.Captures[3]
+			if m.Captures[5] != "" {
+				hourStr, minStr = m.Captures[5], m.Captures[6]
+			}
+
+			hour, ok := cjk.ParseInteger(hourStr)
+			if !ok {
+				hour, _ = strconv.Atoi(hourStr)
+			}
+
+			before, ok := parseInteger(minStr)
+			if !ok {
+				before, _ = strconv.Atoi(minStr)
+			}
+			if before < 1 || before >= 60 || hour > 23 {
+				return false, nil
+			}
+
+			switch cjk.Compress(m.Captures[0]) {
+			case "下午", "晚上", "今晚":
+				if hour < 12 {
+					hour += 12
+				}
+			case "凌晨":
+				if hour == 12 {
+					hour = 0
+				}
+			}
+
+			hour--
+			if hour < 0 {
+				hour = 23
+			}
+			minute := 60 - before
+			zero := 0
+
+			c.Hour = &hour
+			c.Minute = &minute
+			c.Second = &zero
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
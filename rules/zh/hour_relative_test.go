@@ -0,0 +1,32 @@
+package zh_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/zh"
+)
+
+func TestHourRelative(t *testing.T) {
+	fixt := []Fixture{
+		{"差一刻八点", 0, "差一刻八点", 7*time.Hour + 45*time.Minute},
+		{"八点差五分", 0, "八点差五分", 7*time.Hour + 55*time.Minute},
+		{"差10分9点", 0, "差10分9点", 8*time.Hour + 50*time.Minute},
+		{"晚上差一刻十点", 0, "晚上差一刻十点", 21*time.Hour + 45*time.Minute},
+		{"我们下午三点差十分见", 6, "下午三点差十分", 14*time.Hour + 50*time.Minute},
+	}
+
+	w := when.New(nil)
+	w.Add(zh.HourRelative(rules.Override))
+
+	ApplyFixtures(t, "zh.HourRelative", w, fixt)
+
+	fixtnil := []Fixture{
+		{"八点", 0, "", 0},
+		{"差不多八点", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "zh.HourRelative nil", w, fixtnil)
+}
@@ -0,0 +1,76 @@
+package zh
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/cjk"
+)
+
+/*
+	"5分钟前"
+	"三天前"
+	"半小时之前"
+	"两个月前"
+	"几年前" -> 3 years back
+*/
+
+func PastTime(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("" +
+			"([0-9]+|半|几|" + cjk.NUMERAL_PATTERN + ")\\s*(?:个)?\\s*" +
+			"(秒钟?|分钟|分|小时|钟头|天|日|周|星期|礼拜|月|年)\\s*" +
+			"(以前|之前|前)",
+		),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			var amount float64
+			switch m.Captures[0] {
+			case "半":
+				amount = 0.5
+			case "几":
+				amount = 3
+			default:
+				n, ok := cjk.ParseInteger(m.Captures[0])
+				if !ok {
+					n, _ = strconv.Atoi(m.Captures[0])
+				}
+				amount = float64(n)
+			}
+
+			var unit time.Duration
+			switch m.Captures[1] {
+			case "秒", "秒钟":
+				unit = time.Second
+			case "分", "分钟":
+				unit = time.Minute
+			case "小时", "钟头":
+				unit = time.Hour
+			case "天", "日":
+				unit = 24 * time.Hour
+			case "周", "星期", "礼拜":
+				unit = 7 * 24 * time.Hour
+			default:
+				months := amount
+				if m.Captures[1] == "年" {
+					months *= 12
+				}
+				whole, fraction := math.Modf(months)
+				if whole != 0 {
+					shiftMonths(c, ref, -int(whole))
+				}
+				if fraction != 0 {
+					c.Duration = -time.Duration(fraction * float64(30*24*time.Hour))
+				}
+				return true, nil
+			}
+
+			c.Duration = -time.Duration(amount * float64(unit))
+
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
@@ -0,0 +1,34 @@
+package zh_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/zh"
+)
+
+func TestPastTime(t *testing.T) {
+	fixt := []Fixture{
+		{"5分钟前", 0, "5分钟前", -5 * time.Minute},
+		{"他三天前来过", 3, "三天前", -3 * 24 * time.Hour},
+		{"半小时之前", 0, "半小时之前", -30 * time.Minute},
+		{"两个小时以前", 0, "两个小时以前", -2 * time.Hour},
+		{"一周前", 0, "一周前", -7 * 24 * time.Hour},
+		{"两个月前", 0, "两个月前", -59 * 24 * time.Hour},
+		{"几年前", 0, "几年前", -(3*365 + 1) * 24 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(zh.PastTime(rules.Override))
+
+	ApplyFixtures(t, "zh.PastTime", w, fixt)
+
+	fixtnil := []Fixture{
+		{"前天", 0, "", 0},
+		{"三点前", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "zh.PastTime nil", w, fixtnil)
+}
@@ -0,0 +1,59 @@
+package zh
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/common"
+)
+
+/*
+	"下周", "下个星期" -> 9:00 on the next Monday
+	"上周" -> -7 days
+	"下个月" -> the first day of the next month
+	"上个月" -> the previous month
+	"明年", "去年", "今年", "前年", "后年"
+	"本周", "这个月" -> the current week or month
+*/
+
+func RelativeWeek(s rules.Strategy) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile("" +
+			"(下下|上上|下|上|本|这|今|明|去|前|后)\\s*(?:个)?\\s*" +
+			"(周|星期|礼拜|月|年)",
+		),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			period := common.Week
+			switch m.Captures[1] {
+			case "月":
+				period = common.Month
+			case "年":
+				period = common.Year
+			}
+
+			shift := 0
+			switch m.Captures[0] {
+			case "下下", "后":
+				shift = 2
+			case "下", "明":
+				shift = 1
+			case "上上", "前":
+				shift = -2
+			case "上", "去":
+				shift = -1
+			}
+
+			// 今, 明, 去, 前 and 后 only go with the years
+			switch m.Captures[0] {
+			case "今", "明", "去", "前", "后":
+				if period != common.Year {
+					return false, nil
+				}
+			}
+
+			return common.ApplyPeriod(c, ref, period, shift), nil
+		},
+		Strategy: s,
+	}
+}
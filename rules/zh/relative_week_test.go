@@ -0,0 +1,36 @@
+package zh_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/zh"
+)
+
+func TestRelativeWeek(t *testing.T) {
+	fixt := []Fixture{
+		{"下周", 0, "下周", (7*24 + 9) * time.Hour},
+		{"下个星期", 0, "下个星期", (7*24 + 9) * time.Hour},
+		{"上周", 0, "上周", -7 * 24 * time.Hour},
+		{"本周", 0, "本周", 0},
+		{"下个月", 0, "下个月", (18*24 + 9) * time.Hour},
+		{"上个月", 0, "上个月", -28 * 24 * time.Hour},
+		{"明年", 0, "明年", 365 * 24 * time.Hour},
+		{"去年", 0, "去年", -365 * 24 * time.Hour},
+		{"今年", 0, "今年", 0},
+	}
+
+	w := when.New(nil)
+	w.Add(zh.RelativeWeek(rules.Override))
+
+	ApplyFixtures(t, "zh.RelativeWeek", w, fixt)
+
+	fixtnil := []Fixture{
+		{"明月", 0, "", 0},
+		{"今天", 0, "", 0},
+	}
+
+	ApplyFixturesNil(t, "zh.RelativeWeek nil", w, fixtnil)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/cjk"
 )
 
 /*
@@ -29,17 +30,14 @@ func TraditionHour(s rules.Strategy) rules.Rule {
 			"(?:(一\\s?刻|二\\s?刻|两\\s?刻|三\\s?刻|四\\s?刻|五\\s?刻|六\\s?刻|七\\s?刻|1\\s?刻|2\\s?刻|3\\s?刻|4\\s?刻|5\\s?刻|6\\s?刻|7\\s?刻))?",
 		),
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			if c.Hour != nil && s != rules.Override {
-				return false, nil
-			}
-			hour, exist := TRADITION_HOUR_WORDS[compressStr(m.Captures[0])]
+			hour, exist := TRADITION_HOUR_WORDS[cjk.Compress(m.Captures[0])]
 			if !exist {
 				return false, nil
 			}
 			c.Hour = &hour
 			zero := 0
 			c.Minute = &zero
-			if minute, exist := TRADITION_MINUTE_WORDS[compressStr(m.Captures[1])]; exist {
+			if minute, exist := TRADITION_MINUTE_WORDS[cjk.Compress(m.Captures[1])]; exist {
 				if minute > 60 {
 					hour := *c.Hour + 1
 					c.Hour = &hour
@@ -51,13 +49,6 @@ func TraditionHour(s rules.Strategy) rules.Rule {
 			}
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
-
-func compressStr(str string) string {
-	if str == "" {
-		return ""
-	}
-	reg := regexp.MustCompile(`\s+`)
-	return reg.ReplaceAllString(str, "")
-}
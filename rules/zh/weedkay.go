@@ -5,12 +5,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/AlekSi/pointer"
 	"github.com/olebedev/when/rules"
 )
 
 func Weekday(s rules.Strategy) rules.Rule {
-	overwrite := s == rules.Override
-
 	return &rules.F{
 		RegExp: regexp.MustCompile("(?i)" +
 			"(?:(本|这|下|上|这个|下个|上个|下下)\\s*)?" +
@@ -20,8 +19,6 @@ func Weekday(s rules.Strategy) rules.Rule {
 		),
 
 		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
-			_ = overwrite
-
 			if strings.TrimSpace(m.Captures[1]) == "" {
 				return false, nil
 			}
@@ -35,25 +32,28 @@ func Weekday(s rules.Strategy) rules.Rule {
 			if !ok {
 				return false, nil
 			}
+			c.Weekday = pointer.ToInt(dayInt % 7)
 
-			if c.Duration != 0 && !overwrite {
-				return false, nil
+			// weeks start on Monday, so Sunday is the 7th day
+			from := int(ref.Weekday())
+			if from == 0 {
+				from = 7
 			}
 
 			// Switch:
 			switch {
 			case strings.Contains(norm, "上"):
-				diff := int(ref.Weekday()) - dayInt
+				diff := from - dayInt
 				c.Duration = -time.Duration(7+diff) * 24 * time.Hour
 			case strings.Contains(norm, "下下"):
-				diff := dayInt - int(ref.Weekday())
+				diff := dayInt - from
 				c.Duration = time.Duration(7+7+diff) * 24 * time.Hour
 			case strings.Contains(norm, "下"):
-				diff := dayInt - int(ref.Weekday())
+				diff := dayInt - from
 				c.Duration = time.Duration(7+diff) * 24 * time.Hour
 			case strings.Contains(norm, "本") || strings.Contains(norm, "这"):
-				if int(ref.Weekday()) < dayInt {
-					diff := dayInt - int(ref.Weekday())
+				if from < dayInt {
+					diff := dayInt - from
 					if diff > 0 {
 						c.Duration = time.Duration(diff*24) * time.Hour
 					} else if diff < 0 {
@@ -61,8 +61,8 @@ func Weekday(s rules.Strategy) rules.Rule {
 					} else {
 						c.Duration = 7 * 24 * time.Hour
 					}
-				} else if int(ref.Weekday()) > dayInt {
-					diff := int(ref.Weekday()) - dayInt
+				} else if from > dayInt {
+					diff := from - dayInt
 					if diff > 0 {
 						c.Duration = -time.Duration(diff*24) * time.Hour
 					} else if diff < 0 {
@@ -75,5 +75,6 @@ func Weekday(s rules.Strategy) rules.Rule {
 
 			return true, nil
 		},
+		Strategy: s,
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/olebedev/when"
 	"github.com/olebedev/when/rules"
 	"github.com/olebedev/when/rules/zh"
+	"github.com/stretchr/testify/require"
 )
 
 func TestWeekday(t *testing.T) {
@@ -34,3 +35,26 @@ func TestWeekday(t *testing.T) {
 
 	ApplyFixtures(t, "zh.Weekday", w, fixt)
 }
+
+func TestWeekdayFromSunday(t *testing.T) {
+	// weeks start on Monday, so Sunday is the last day of the week
+	sunday := now.AddDate(0, 0, -1)
+
+	fixt := []Fixture{
+		{"下周一", 0, "下周一", 1 * 24 * time.Hour},
+		{"下周日", 0, "下周日", 7 * 24 * time.Hour},
+		{"本周一", 0, "本周一", -6 * 24 * time.Hour},
+		{"上周五", 0, "上周五", -9 * 24 * time.Hour},
+	}
+
+	w := when.New(nil)
+	w.Add(zh.Weekday(rules.Override))
+
+	for i, f := range fixt {
+		res, err := w.Parse(f.Text, sunday)
+		require.Nil(t, err, "[zh.Weekday] err #%d", i)
+		require.NotNil(t, res, "[zh.Weekday] res #%d", i)
+		require.Equal(t, f.Phrase, res.Text, "[zh.Weekday] text #%d", i)
+		require.Equal(t, f.Diff, res.Time.Sub(sunday), "[zh.Weekday] diff #%d", i)
+	}
+}
@@ -0,0 +1,115 @@
+package when_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/en"
+	"github.com/stretchr/testify/require"
+)
+
+// shift returns a rule matching the word, moving the time by the duration
+// and setting the hour
+func shift(s rules.Strategy, word string, d time.Duration, hour int) rules.Rule {
+	return &rules.F{
+		RegExp: regexp.MustCompile(`\b(` + word + `)\b`),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			c.Duration = d
+			c.Hour = pointer.ToInt(hour)
+			return true, nil
+		},
+		Strategy: s,
+	}
+}
+
+func TestStrategy(t *testing.T) {
+	fixt := []struct {
+		name string
+		s    rules.Strategy
+		want time.Time
+	}{
+		// the last values
+		{"override", rules.Override, tomorrow.AddDate(0, 0, 2).Add(7 * time.Hour)},
+		// the first values
+		{"skip", rules.Skip, tomorrow.Add(5 * time.Hour)},
+		// a rule made without a strategy skips
+		{"zero", rules.Strategy(0), tomorrow.Add(5 * time.Hour)},
+		// the durations add up, the first hour is kept
+		{"merge", rules.Merge, tomorrow.AddDate(0, 0, 3).Add(5 * time.Hour)},
+	}
+
+	for _, f := range fixt {
+		w := when.New(nil)
+		w.Add(shift(rules.Override, "one", 24*time.Hour, 5))
+		w.Add(shift(f.s, "two", 72*time.Hour, 7))
+
+		res, err := w.Parse("one two", base)
+		require.Nil(t, err, f.name)
+		require.NotNil(t, res, f.name)
+		require.Equal(t, f.want, res.Time, f.name)
+	}
+}
+
+func TestStrategyUnsetFields(t *testing.T) {
+	// the fields not set yet are set by all the strategies
+	for _, s := range []rules.Strategy{rules.Override, rules.Skip, rules.Merge} {
+		w := when.New(nil)
+		w.Add(shift(s, "two", 48*time.Hour, 7))
+
+		res, err := w.Parse("two", base)
+		require.Nil(t, err)
+		require.Equal(t, friday.Add(7*time.Hour), res.Time, "strategy %d", s)
+	}
+}
+
+func TestStrategyLocale(t *testing.T) {
+	w := when.New(nil)
+	w.Add(en.Weekday(rules.Override), en.Hour(rules.Override))
+	res, err := w.Parse("next friday at 5pm", base)
+	require.Nil(t, err)
+	require.Equal(t, friday.Add(17*time.Hour), res.Time)
+
+	// the weekday sets 9:00 first
+	w = when.New(nil)
+	w.Add(en.Weekday(rules.Skip), en.Hour(rules.Skip))
+	res, err = w.Parse("next friday at 5pm", base)
+	require.Nil(t, err)
+	require.Equal(t, friday.Add(9*time.Hour), res.Time)
+
+	// the durations add up, the 9:00 of tomorrow is kept
+	w = when.New(nil)
+	w.Add(en.CasualDate(rules.Override), en.Deadline(rules.Merge))
+	res, err = w.Parse("tomorrow in 2 hours", base)
+	require.Nil(t, err)
+	require.Equal(t, tomorrow.Add(9*time.Hour), res.Time)
+
+	// the last duration with Override
+	w = when.New(nil)
+	w.Add(en.CasualDate(rules.Override), en.Deadline(rules.Override))
+	res, err = w.Parse("tomorrow in 2 hours", base)
+	require.Nil(t, err)
+	require.Equal(t, today.Add(9*time.Hour), res.Time)
+}
+
+func TestRefused(t *testing.T) {
+	refuse := &rules.F{
+		RegExp: regexp.MustCompile(`\b(two)\b`),
+		Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+			c.Hour = pointer.ToInt(7)
+			return false, nil
+		},
+		Strategy: rules.Override,
+	}
+
+	w := when.New(nil)
+	w.Add(shift(rules.Override, "one", 24*time.Hour, 5), refuse)
+
+	// the refused match leaves the context as is
+	res, err := w.Parse("one two", base)
+	require.Nil(t, err)
+	require.Equal(t, tomorrow.Add(5*time.Hour), res.Time)
+}
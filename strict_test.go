@@ -0,0 +1,86 @@
+package when_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/en"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrict(t *testing.T) {
+	o := &rules.Options{Distance: 5, MatchByOrder: true, Strict: true}
+
+	fixt := []struct {
+		text string
+		time time.Time
+	}{
+		{"Monday March 7", time.Date(2016, 3, 7, 9, 0, 0, 0, time.UTC)},
+		{"at 7pm", today.Add(19 * time.Hour)},
+		{"on 13/4", time.Date(2016, 4, 13, 10, 0, 0, 0, time.UTC)},
+		{"2016-02-29", time.Date(2016, 2, 29, 10, 0, 0, 0, time.UTC)},
+		{"february 29", time.Date(2016, 2, 29, 10, 0, 0, 0, time.UTC)},
+		{"tomorrow at midnight", tomorrow.AddDate(0, 0, 1)},
+		{"friday", friday.Add(9 * time.Hour)},
+		{"at 23:59", today.Add(23*time.Hour + 59*time.Minute)},
+	}
+
+	w := newParser(o, en.All)
+	for _, f := range fixt {
+		res, err := w.Parse(f.text, base)
+		require.Nil(t, err, f.text)
+		require.NotNil(t, res, f.text)
+		require.Equal(t, f.time, res.Time, f.text)
+	}
+
+	nilFixt := []struct {
+		text  string
+		cause error
+		msg   string
+	}{
+		{"February 30th", rules.ErrInvalidDate, "day 30 of February: invalid date"},
+		{"february 30", rules.ErrInvalidDate, "day 30 of February: invalid date"},
+		{"feb 30 2016", rules.ErrInvalidDate, "day 30 of 2016-02: invalid date"},
+		{"at 25", rules.ErrInvalidDate, "hour 25: invalid date"},
+		{"at 25:00", rules.ErrInvalidDate, "hour 25: invalid date"},
+		{"at 12:61", rules.ErrInvalidDate, "minute 61: invalid date"},
+		{"at 13:30pm", rules.ErrInvalidDate, "hour 13 pm: invalid date"},
+		{"2017-02-29", rules.ErrInvalidDate, "day 29 of 2017-02: invalid date"},
+		{"2100-02-29", rules.ErrInvalidDate, "day 29 of 2100-02: invalid date"},
+		{"Tuesday March 7", rules.ErrContradiction, `"Tuesday March 7": March 7, 2016 is not Tuesday: contradictory date`},
+		{"at 7 o'clock", rules.ErrAmbiguous, `"7 o'clock" could be read as pm: ambiguous date`},
+		{"next friday", rules.ErrAmbiguous, `"next friday" could be read as week after: ambiguous date`},
+		{"on 3/4", rules.ErrAmbiguous, `"3/4" could be read as month/day: ambiguous date`},
+	}
+
+	for _, f := range nilFixt {
+		res, err := w.Parse(f.text, base)
+		require.Nil(t, res, f.text)
+		require.Equal(t, f.cause, errors.Cause(err), f.text)
+		require.EqualError(t, err, f.msg, f.text)
+	}
+}
+
+func TestStrictOff(t *testing.T) {
+	w := newParser(nil, en.All)
+
+	// the day goes on to the next month
+	res, err := w.Parse("February 30th", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, time.March, res.Time.Month())
+
+	// the day goes over the weekday
+	res, err = w.Parse("Tuesday March 7", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, time.Date(2016, 3, 7, 9, 0, 0, 0, time.UTC), res.Time)
+
+	for _, text := range []string{"at 25", "at 25:00"} {
+		res, err = w.Parse(text, base)
+		require.Nil(t, err, text)
+		require.Nil(t, res, text)
+	}
+}
@@ -0,0 +1,239 @@
+package when
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/olebedev/when/rules"
+)
+
+// Trace tells how Parse came to its result, see ParseWithTrace
+type Trace struct {
+	// Text is the text the rules got, after the middlewares
+	Text string
+	// Matches holds all the matches of the rules, in the order of the rules
+	Matches []*TraceMatch
+	// Applied holds the matches of the cluster in the order they were
+	// applied, the ones the rules refused too
+	Applied []*TraceMatch
+	// Fields holds the match that set each field of the context last, by
+	// the name of the field like "Hour" or "Duration"
+	Fields map[string]*TraceMatch
+
+	matches map[*rules.Match]*TraceMatch
+}
+
+// TraceMatch is a match of a rule and what it did
+type TraceMatch struct {
+	// Rule is the index of the rule in the parser, Name is its rules.Meta
+	// name if it has one
+	Rule int
+	Name string
+	// Left and Right are the borders of the match in Trace.Text
+	Left, Right int
+	Text        string
+	Captures    []string
+	// Refused tells if the rule refused the match on its own, so it did not
+	// compete for the text
+	Refused bool
+	// Overlapped tells if the match lost its part of the text to a better
+	// match overlapping it
+	Overlapped bool
+	// Clustered tells if the match got into the cluster, the ones too far
+	// from it and the next ones of a rule in it are dropped
+	Clustered bool
+	// Applied tells if the rule took the match, Err holds its error if any
+	Applied bool
+	Err     error
+	// Before and After are the context before and after the rule applied
+	// the match, Fields are the names of the fields it set, see rules.Match
+	Before, After rules.Context
+	Fields        []string
+}
+
+// ParseWithTrace parses the text as Parse does and tells how it came to
+// the result. The trace is returned along with no result or an error too.
+func (p *Parser) ParseWithTrace(text string, base time.Time) (*Result, *Trace, error) {
+	trace := &Trace{
+		Fields:  make(map[string]*TraceMatch),
+		matches: make(map[*rules.Match]*TraceMatch),
+	}
+	res, err := p.parse(text, base, nil, trace)
+	return res, trace, err
+}
+
+// text records the text the rules got
+func (t *Trace) text(text string) {
+	if t != nil {
+		t.Text = text
+	}
+}
+
+// match records the match of the i-th rule
+func (t *Trace) match(i int, name string, m *rules.Match) {
+	if t == nil {
+		return
+	}
+	tm := &TraceMatch{
+		Rule:     i,
+		Name:     name,
+		Left:     m.Left,
+		Right:    m.Right,
+		Text:     m.Text,
+		Captures: m.Captures,
+	}
+	t.Matches = append(t.Matches, tm)
+	t.matches[m] = tm
+}
+
+// refuse marks the match the rule refused on its own
+func (t *Trace) refuse(m *rules.Match) {
+	if t == nil {
+		return
+	}
+	if tm, ok := t.matches[m]; ok {
+		tm.Refused = true
+	}
+}
+
+// resolve marks the matches lost to the overlapping ones
+func (t *Trace) resolve(kept []*rules.Match) {
+	if t == nil {
+		return
+	}
+	for m, tm := range t.matches {
+		tm.Overlapped = !tm.Refused
+		for _, k := range kept {
+			if k == m {
+				tm.Overlapped = false
+				break
+			}
+		}
+	}
+}
+
+// cluster marks the matches of the cluster
+func (t *Trace) cluster(matches []*rules.Match) {
+	if t == nil {
+		return
+	}
+	for _, m := range matches {
+		if tm, ok := t.matches[m]; ok {
+			tm.Clustered = true
+		}
+	}
+}
+
+// apply records the way the match changed the context
+func (t *Trace) apply(m *rules.Match, before rules.Context, c *rules.Context, applied bool, err error) {
+	if t == nil {
+		return
+	}
+	tm, ok := t.matches[m]
+	if !ok {
+		return
+	}
+
+	tm.Applied, tm.Err = applied, err
+	tm.Before, tm.After = before, c.Copy()
+	if applied {
+		tm.Fields = m.Fields
+	}
+	for _, f := range tm.Fields {
+		if v := field(&tm.After, f); v == "nil" || v == "0s" {
+			delete(t.Fields, f)
+		} else {
+			t.Fields[f] = tm
+		}
+	}
+	t.Applied = append(t.Applied, tm)
+}
+
+// String returns the trace in a human-readable form
+func (t *Trace) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "text: %q\n", t.Text)
+
+	b.WriteString("matches:\n")
+	for _, m := range t.Matches {
+		state := "dropped"
+		switch {
+		case m.Refused:
+			state = "refused"
+		case m.Overlapped:
+			state = "overlapped"
+		case m.Clustered:
+			state = "clustered"
+		}
+		fmt.Fprintf(&b, "  %s [%d:%d] %q %q %s\n", m.rule(), m.Left, m.Right, m.Text, m.Captures, state)
+	}
+
+	b.WriteString("applied:\n")
+	for _, m := range t.Applied {
+		fmt.Fprintf(&b, "  %s %q:", m.rule(), m.Text)
+		switch {
+		case m.Err != nil:
+			fmt.Fprintf(&b, " error: %v", m.Err)
+		case !m.Applied:
+			b.WriteString(" refused")
+		}
+		for _, f := range m.Fields {
+			fmt.Fprintf(&b, " %s %s -> %s", f, field(&m.Before, f), field(&m.After, f))
+		}
+		b.WriteByte('\n')
+	}
+
+	b.WriteString("fields:\n")
+	for _, f := range contextFields {
+		if m, ok := t.Fields[f]; ok {
+			fmt.Fprintf(&b, "  %s %s by %s %q\n", f, field(&m.After, f), m.rule(), m.Text)
+		}
+	}
+	return b.String()
+}
+
+// rule returns the name of the rule of the match or its index if it has
+// no name
+func (m *TraceMatch) rule() string {
+	if m.Name != "" {
+		return m.Name
+	}
+	return fmt.Sprintf("rule %d", m.Rule)
+}
+
+// contextFields are the names of the fields of rules.Context the rules set
+var contextFields = []string{"Duration", "Year", "Month", "Weekday", "Day", "Hour", "Minute", "Second", "Location"}
+
+// field returns the value of the field of the context as a string, "nil"
+// if it is not set
+func field(c *rules.Context, name string) string {
+	var v *int
+	switch name {
+	case "Duration":
+		return c.Duration.String()
+	case "Location":
+		if c.Location == nil {
+			return "nil"
+		}
+		return c.Location.String()
+	case "Year":
+		v = c.Year
+	case "Month":
+		v = c.Month
+	case "Weekday":
+		v = c.Weekday
+	case "Day":
+		v = c.Day
+	case "Hour":
+		v = c.Hour
+	case "Minute":
+		v = c.Minute
+	case "Second":
+		v = c.Second
+	}
+	if v == nil {
+		return "nil"
+	}
+	return fmt.Sprint(*v)
+}
@@ -0,0 +1,91 @@
+package when_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/when/rules/en"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWithTrace(t *testing.T) {
+	w := newParser(nil, en.All)
+
+	res, trace, err := w.ParseWithTrace("next friday at 5pm", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, "next friday at 5pm", trace.Text)
+
+	texts := make(map[string]bool)
+	for _, m := range trace.Matches {
+		require.Equal(t, trace.Text[m.Left:m.Right], m.Text)
+		if !m.Overlapped {
+			texts[m.Text] = true
+			require.True(t, m.Clustered, m.Text)
+		}
+	}
+	require.Equal(t, map[string]bool{"next friday": true, "5pm": true}, texts)
+
+	require.Len(t, trace.Applied, len(texts))
+	require.Equal(t, "next friday", trace.Fields["Duration"].Text)
+	require.Equal(t, "5pm", trace.Fields["Hour"].Text)
+	require.Equal(t, "en.hour", trace.Fields["Hour"].Name)
+	require.Equal(t, "5pm", trace.Fields["Minute"].Text)
+	require.Equal(t, "next friday", trace.Fields["Weekday"].Text)
+
+	// the weekday sets the default hour, the next rule overrides it
+	hour := trace.Fields["Hour"]
+	require.True(t, hour.Applied)
+	require.Equal(t, 9, *hour.Before.Hour)
+	require.Equal(t, 17, *hour.After.Hour)
+	require.Equal(t, []string{"Hour", "Minute", "Second"}, hour.Fields)
+
+	// the rules applied later see the changes of the earlier ones
+	for i, m := range trace.Applied[1:] {
+		require.Equal(t, trace.Applied[i].After, m.Before)
+	}
+
+	require.Contains(t, trace.String(), "Hour 9 -> 17")
+}
+
+func TestParseWithTraceDropped(t *testing.T) {
+	w := newParser(nil, en.All)
+
+	res, trace, err := w.ParseWithTrace("tomorrow, after my very long meeting, at 3pm", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, "tomorrow", res.Text)
+
+	var dropped []string
+	for _, m := range trace.Matches {
+		if !m.Clustered {
+			dropped = append(dropped, m.Text)
+		}
+	}
+	require.Equal(t, []string{"3pm"}, dropped)
+	require.Len(t, trace.Applied, 1)
+	require.Equal(t, "tomorrow", trace.Fields["Hour"].Text)
+	require.Contains(t, trace.String(), "dropped")
+}
+
+func TestParseWithTraceNoMatch(t *testing.T) {
+	w := newParser(nil, en.All)
+
+	res, trace, err := w.ParseWithTrace("nothing to see here", base)
+	require.Nil(t, err)
+	require.Nil(t, res)
+	require.Equal(t, "nothing to see here", trace.Text)
+	require.Empty(t, trace.Matches)
+	require.Empty(t, trace.Applied)
+}
+
+func TestParseWithTraceSameResult(t *testing.T) {
+	w := newParser(nil, en.All)
+
+	res, err := w.Parse("next friday at 5pm", base)
+	require.Nil(t, err)
+	traced, _, err := w.ParseWithTrace("next friday at 5pm", base)
+	require.Nil(t, err)
+	require.Equal(t, res, traced)
+	require.Equal(t, time.Date(2016, 1, 8, 17, 0, 0, 0, time.UTC), res.Time)
+}
@@ -1,15 +1,25 @@
 package when
 
 import (
+	"regexp"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ar"
 	"github.com/olebedev/when/rules/br"
 	"github.com/olebedev/when/rules/common"
+	"github.com/olebedev/when/rules/de"
 	"github.com/olebedev/when/rules/en"
+	"github.com/olebedev/when/rules/es"
+	"github.com/olebedev/when/rules/fr"
+	"github.com/olebedev/when/rules/ja"
 	"github.com/olebedev/when/rules/nl"
+	"github.com/olebedev/when/rules/pl"
 	"github.com/olebedev/when/rules/ru"
+	"github.com/olebedev/when/rules/uk"
+	"github.com/olebedev/when/rules/zh"
 	"github.com/pkg/errors"
 )
 
@@ -18,23 +28,48 @@ import (
 type Parser struct {
 	options    *rules.Options
 	rules      []rules.Rule
-	middleware []func(string) (string, error)
+	middleware []func(string) (string, rules.Offsets, error)
+	connectors map[string]bool
+	stops      []*regexp.Regexp
 }
 
 // Result is a struct which contains parsing meta-info
 type Result struct {
-	// Index is a start index
+	// Index is a start index in Source, in bytes
 	Index int
-	// Text is a text found and processed
+	// Text is a text found and processed, as it is in Source
 	Text string
+	// Start and End are the borders of Text in Source
+	Start, End Position
 	// Source is input string
 	Source string
 	// Time is an output time
 	Time time.Time
+	// Locale is a name of the detected language, set by Multi
+	Locale string
+	// Confidence is the likelihood of Time from 0 to 1, it is less than 1
+	// for an ambiguous text, Labels name the assumptions Time is made on
+	Confidence float64
+	Labels     []string
+	// Alternatives holds the other readings of an ambiguous text, the most
+	// likely first, if Options.Alternatives is set
+	Alternatives []Alternative
+	// Inferred tells if a part of Time is guessed, not said in the text,
+	// like the pm of "lunch at 1" read by rules.MeridiemInfer
+	Inferred bool
+	// Parts are the matches of the rules Time is made of, in the order of
+	// the text, so that e.g. the date and the time of it can be told apart
+	Parts []Part
 }
 
 // Parse returns Result and error if any. If have not matches it returns nil, nil.
 func (p *Parser) Parse(text string, base time.Time) (*Result, error) {
+	return p.parse(text, base, nil, nil)
+}
+
+// parse parses the text with the stop patterns of the parser and the extra
+// ones, and records the way to the result in the trace unless it is nil
+func (p *Parser) parse(text string, base time.Time, stops []*regexp.Regexp, trace *Trace) (*Result, error) {
 	res := Result{
 		Source: text,
 		Time:   base,
@@ -46,84 +81,270 @@ func (p *Parser) Parse(text string, base time.Time) (*Result, error) {
 	}
 
 	var err error
-	// apply middlewares
-	for _, b := range p.middleware {
-		text, err = b(text)
+	// apply middlewares, keeping the way back to the source
+	offsets := make([]rules.Offsets, len(p.middleware))
+	for i, b := range p.middleware {
+		text, offsets[i], err = b(text)
 		if err != nil {
 			return nil, err
 		}
 	}
+	trace.text(text)
 
-	// find all matches
-	matches := make([]*rules.Match, 0)
+	// find all the candidates
+	candidates := make([]candidate, 0)
+	metas := make(map[*rules.Match]rules.Meta)
 	c := float64(0)
-	for _, rule := range p.rules {
-		r := rule.Find(text)
-		if r != nil {
+	for i, rule := range p.rules {
+		found := rules.FindAll(rule, text)
+		if len(found) == 0 {
+			continue
+		}
+		meta := rules.MetaOf(rule)
+		for _, r := range found {
+			metas[r] = meta
 			r.Order = c
-			c++
-			matches = append(matches, r)
+			candidates = append(candidates, candidate{Match: r, priority: meta.Priority})
+			trace.match(i, meta.Name, r)
 		}
+		c++
 	}
 
+	// drop the matches of the stop patterns and the ones the rules refuse
+	// on their own
+	stops = append(stops[:len(stops):len(stops)], p.stops...)
+	candidates = unstopped(candidates, rules.FindStops(text, stops...), trace)
+	candidates = viable(candidates, p.options, base, trace)
+
 	// not found
-	if len(matches) == 0 {
+	if len(candidates) == 0 {
 		return nil, nil
 	}
 
-	// find a cluster
-	sort.Sort(rules.MatchByIndex(matches))
+	// resolve the overlaps, a rule is applied once
+	matches := resolve(candidates)
+	trace.resolve(matches)
+	matches = firstOfRule(matches)
 
 	// get borders of the matches
-	end := matches[0].Right
-	res.Index = matches[0].Left
+	var end int
+	res.Index, end = span(matches[0], candidates)
 
 	for i, m := range matches {
-		if m.Left <= end+p.options.Distance {
-			end = m.Right
+		left, right := span(m, candidates)
+		if p.near(text, end, left, right) {
+			if left < res.Index {
+				res.Index = left
+			}
+			if right > end {
+				end = right
+			}
 		} else {
 			matches = matches[:i]
 			break
 		}
 	}
+	trace.cluster(matches)
 
-	res.Text = text[res.Index:end]
+	ctx := &rules.Context{Text: text[res.Index:end]}
+
+	for i := len(offsets) - 1; i >= 0; i-- {
+		res.Index, end = offsets[i].Map(res.Index), offsets[i].Map(end)
+	}
+	res.Text = res.Source[res.Index:end]
+	res.Start = position(res.Source, res.Index)
+	res.End = position(res.Source, end)
 
 	// apply rules
 	if p.options.MatchByOrder {
 		sort.Sort(rules.MatchByOrder(matches))
 	}
 
-	ctx := &rules.Context{Text: res.Text}
-	applied := false
+	var applied []*rules.Match
 	for _, applier := range matches {
+		before := ctx.Copy()
 		ok, err := applier.Apply(ctx, p.options, res.Time)
+		trace.apply(applier, before, ctx, ok, err)
 		if err != nil {
 			return nil, err
 		}
-		applied = ok || applied
+		if ok {
+			applied = append(applied, applier)
+		}
 	}
 
-	if !applied {
+	if len(applied) == 0 {
 		return nil, nil
 	}
+	res.Parts = parts(applied, metas, offsets, res.Source)
 
-	res.Time, err = ctx.Time(res.Time)
+	res.Time, err = bind(ctx, res.Time, p.options.Strict)
 	if err != nil {
 		return nil, errors.Wrap(err, "bind context")
 	}
 
+	if err := settle(ctx, base, res.Time, p.options.Strict); err != nil {
+		return nil, err
+	}
+	res.Confidence, res.Labels = reading(ctx)
+	res.Inferred = ctx.Inferred
+	var others []Alternative
+	if p.options.Alternatives || p.options.Strict {
+		others, err = alternatives(ctx, base, res.Time, p.options.Strict)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.options.Alternatives {
+		res.Alternatives = others
+	}
+
+	// the text reads to the time as it is and to it only in strict mode
+	if p.options.Strict {
+		if err := ctx.Check(res.Time); err != nil {
+			return nil, errors.Wrapf(err, "%q", res.Text)
+		}
+		if len(others) > 0 {
+			return nil, errors.Wrapf(rules.ErrAmbiguous, "%q could be read as %s",
+				res.Text, strings.Join(others[0].Labels, " and "))
+		}
+	}
+
 	return &res, nil
 }
 
-// Add adds  given rules to the main chain.
+// bind returns the time the context reads to. In strict mode the weekday
+// does not move the day or the duration said, Context.Check tells if they
+// agree.
+func bind(c *rules.Context, t time.Time, strict bool) (time.Time, error) {
+	if strict && c.Weekday != nil && (c.Day != nil || c.Duration != 0) {
+		cp := c.Copy()
+		cp.Weekday = nil
+		c = &cp
+	}
+	return c.Time(t)
+}
+
+// Add adds  given rules to the main chain. The rules go in the order of
+// their rules.Meta priority, the ones of the same priority in the order
+// they were added in.
 func (p *Parser) Add(r ...rules.Rule) {
 	p.rules = append(p.rules, r...)
+	p.sort()
+}
+
+// Remove removes the rules with the given rules.Meta name from the chain
+// and tells if there were any.
+func (p *Parser) Remove(name string) bool {
+	kept := p.rules[:0]
+	for _, r := range p.rules {
+		if rules.MetaOf(r).Name != name {
+			kept = append(kept, r)
+		}
+	}
+	removed := len(kept) < len(p.rules)
+	p.rules = kept
+	return removed
+}
+
+// Replace puts the rule in place of the rules with the given rules.Meta
+// name and tells if there were any, the rule goes by its own priority.
+func (p *Parser) Replace(name string, r rules.Rule) bool {
+	replaced := false
+	kept := p.rules[:0]
+	for _, old := range p.rules {
+		switch {
+		case rules.MetaOf(old).Name != name:
+			kept = append(kept, old)
+		case !replaced:
+			kept = append(kept, r)
+			replaced = true
+		}
+	}
+	p.rules = kept
+	p.sort()
+	return replaced
+}
+
+// Rules returns the rules of the chain in the order they are checked in.
+func (p *Parser) Rules() []rules.Rule {
+	return append([]rules.Rule(nil), p.rules...)
+}
+
+// sort orders the rules by their priority, keeping the order of the ones
+// of the same priority. A rule without metadata takes the priority of the
+// rule before it, the first ones the priority of the first described rule,
+// so it stays where it was added among them.
+func (p *Parser) sort() {
+	type ranked struct {
+		rule     rules.Rule
+		priority int
+	}
+
+	priority := 0
+	for _, r := range p.rules {
+		if d, ok := r.(rules.Described); ok {
+			priority = d.Meta().Priority
+			break
+		}
+	}
+
+	list := make([]ranked, len(p.rules))
+	for i, r := range p.rules {
+		if d, ok := r.(rules.Described); ok {
+			priority = d.Meta().Priority
+		}
+		list[i] = ranked{r, priority}
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].priority > list[j].priority
+	})
+	for i, r := range list {
+		p.rules[i] = r.rule
+	}
 }
 
-// Use adds give functions to middlewares.
+// Use adds give functions to middlewares. The result points into the text
+// they got as far as rules.Diff can tell, see Normalize for the middlewares
+// knowing their offsets.
 func (p *Parser) Use(f ...func(string) (string, error)) {
-	p.middleware = append(p.middleware, f...)
+	for _, b := range f {
+		b := b
+		p.middleware = append(p.middleware, func(text string) (string, rules.Offsets, error) {
+			out, err := b(text)
+			return out, rules.Diff(text, out), err
+		})
+	}
+}
+
+// Normalize adds given normalizers to middlewares, the result keeps
+// pointing into the text they got.
+func (p *Parser) Normalize(n ...rules.Normalizer) {
+	for _, b := range n {
+		b := b
+		p.middleware = append(p.middleware, func(text string) (string, rules.Offsets, error) {
+			out, offsets := b(text)
+			return out, offsets, nil
+		})
+	}
+}
+
+// Connect adds the connectors of the language, the words like "at" or "on"
+// joining the parts of a time, see rules.ClusterByTokens.
+func (p *Parser) Connect(words ...string) {
+	if p.connectors == nil {
+		p.connectors = make(map[string]bool, len(words))
+	}
+	for _, w := range words {
+		p.connectors[strings.ToLower(w)] = true
+	}
+}
+
+// Stop adds the stop patterns of the language, the matches of the rules
+// overlapping theirs are dropped, like the "1200" of "room 1200".
+func (p *Parser) Stop(patterns ...*regexp.Regexp) {
+	p.stops = append(p.stops, patterns...)
 }
 
 // SetOptions sets options object to use.
@@ -157,20 +378,101 @@ var BR *Parser
 // NL is a parser for Dutch language
 var NL *Parser
 
+// DE is a parser for German language
+var DE *Parser
+
+// FR is a parser for French language
+var FR *Parser
+
+// ES is a parser for Spanish language
+var ES *Parser
+
+// JA is a parser for Japanese language
+var JA *Parser
+
+// ZH is a parser for Chinese language
+var ZH *Parser
+
+// UK is a parser for Ukrainian language
+var UK *Parser
+
+// PL is a parser for Polish language
+var PL *Parser
+
+// AR is a parser for Arabic language, it reads the Arabic-Indic digits too
+var AR *Parser
+
 func init() {
 	EN = New(nil)
 	EN.Add(en.All...)
 	EN.Add(common.All...)
+	EN.Connect(en.CONNECTORS...)
+	EN.Stop(en.STOPS...)
 
 	RU = New(nil)
 	RU.Add(ru.All...)
 	RU.Add(common.All...)
+	RU.Connect(ru.CONNECTORS...)
+	RU.Stop(ru.STOPS...)
 
 	BR = New(nil)
 	BR.Add(br.All...)
 	BR.Add(common.All...)
+	BR.Connect(br.CONNECTORS...)
+	BR.Stop(br.STOPS...)
 
 	NL = New(nil)
 	NL.Add(nl.All...)
 	NL.Add(common.All...)
+	NL.Connect(nl.CONNECTORS...)
+	NL.Stop(nl.STOPS...)
+
+	DE = New(nil)
+	DE.Add(de.All...)
+	DE.Add(common.All...)
+	DE.Connect(de.CONNECTORS...)
+	DE.Stop(de.STOPS...)
+
+	FR = New(nil)
+	FR.Add(fr.All...)
+	FR.Add(common.All...)
+	FR.Connect(fr.CONNECTORS...)
+	FR.Stop(fr.STOPS...)
+
+	ES = New(nil)
+	ES.Add(es.All...)
+	ES.Add(common.All...)
+	ES.Connect(es.CONNECTORS...)
+	ES.Stop(es.STOPS...)
+
+	JA = New(nil)
+	JA.Add(ja.All...)
+	JA.Add(common.All...)
+	JA.Connect(ja.CONNECTORS...)
+
+	ZH = New(nil)
+	ZH.Add(zh.All...)
+	ZH.Add(common.All...)
+	ZH.Connect(zh.CONNECTORS...)
+
+	UK = New(nil)
+	UK.Add(uk.All...)
+	UK.Add(common.All...)
+	UK.Connect(uk.CONNECTORS...)
+	UK.Stop(uk.STOPS...)
+
+	PL = New(nil)
+	PL.Add(pl.All...)
+	PL.Add(common.All...)
+	PL.Connect(pl.CONNECTORS...)
+	PL.Stop(pl.STOPS...)
+
+	AR = New(nil)
+	AR.Normalize(rules.Digits)
+	AR.Add(ar.All...)
+	AR.Add(common.All...)
+	AR.Connect(ar.CONNECTORS...)
+	AR.Stop(ar.STOPS...)
+
+	initLocales()
 }
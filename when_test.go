@@ -0,0 +1,367 @@
+package when_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/olebedev/when"
+	"github.com/olebedev/when/rules"
+	"github.com/olebedev/when/rules/ar"
+	"github.com/olebedev/when/rules/br"
+	"github.com/olebedev/when/rules/common"
+	"github.com/olebedev/when/rules/de"
+	"github.com/olebedev/when/rules/en"
+	"github.com/olebedev/when/rules/es"
+	"github.com/olebedev/when/rules/fr"
+	"github.com/olebedev/when/rules/ja"
+	"github.com/olebedev/when/rules/nl"
+	"github.com/olebedev/when/rules/pl"
+	"github.com/olebedev/when/rules/ru"
+	"github.com/olebedev/when/rules/uk"
+	"github.com/olebedev/when/rules/zh"
+	"github.com/stretchr/testify/require"
+)
+
+// Wednesday, January 6, 2016 10:00
+var base = time.Date(2016, time.January, 6, 10, 0, 0, 0, time.UTC)
+
+var (
+	today    = time.Date(2016, time.January, 6, 0, 0, 0, 0, time.UTC)
+	tomorrow = today.AddDate(0, 0, 1)
+	friday   = today.AddDate(0, 0, 2)
+	saturday = today.AddDate(0, 0, 3)
+)
+
+func newParser(o *rules.Options, r ...[]rules.Rule) *when.Parser {
+	w := when.New(o)
+	for _, rr := range r {
+		w.Add(rr...)
+	}
+	w.Add(common.All...)
+	return w
+}
+
+func TestMidnightNoon(t *testing.T) {
+	fixt := []struct {
+		lang string
+		all  []rules.Rule
+		text string
+		want time.Time
+	}{
+		{"en", en.All, "midnight", tomorrow},
+		{"en", en.All, "at midnight tonight", tomorrow},
+		{"en", en.All, "midnight friday", saturday},
+		{"en", en.All, "on friday at midnight", saturday},
+		{"en", en.All, "noon tomorrow", tomorrow.Add(12 * time.Hour)},
+		{"en", en.All, "12am", today},
+		{"en", en.All, "12:30am", today.Add(30 * time.Minute)},
+		{"en", en.All, "12pm", today.Add(12 * time.Hour)},
+
+		{"ru", ru.All, "в полночь", tomorrow},
+		{"ru", ru.All, "в пятницу в полночь", saturday},
+		{"ru", ru.All, "завтра в полдень", tomorrow.Add(12 * time.Hour)},
+		{"ru", ru.All, "12 ночи", tomorrow},
+		{"ru", ru.All, "в 6 ночи", today.Add(6 * time.Hour)},
+		{"ru", ru.All, "в 11 ночи", today.Add(23 * time.Hour)},
+		{"ru", ru.All, "в 12:30 ночи", tomorrow.Add(30 * time.Minute)},
+
+		{"nl", nl.All, "om middernacht", tomorrow},
+		{"nl", nl.All, "vrijdag om middernacht", saturday},
+		{"nl", nl.All, "middaguur", today.Add(12 * time.Hour)},
+		{"nl", nl.All, "12am", today},
+		{"nl", nl.All, "12pm", today.Add(12 * time.Hour)},
+
+		{"br", br.All, "à meia-noite", tomorrow},
+		{"br", br.All, "sexta à meia-noite", saturday},
+		{"br", br.All, "amanhã ao meio-dia", tomorrow.Add(12 * time.Hour)},
+		{"br", br.All, "12am", today},
+		{"br", br.All, "12pm", today.Add(12 * time.Hour)},
+
+		{"zh", zh.All, "午夜", tomorrow},
+		{"zh", zh.All, "星期五午夜", saturday},
+		{"zh", zh.All, "今晚12点", tomorrow},
+		{"zh", zh.All, "凌晨12点", today},
+		{"zh", zh.All, "明天正午", tomorrow.Add(12 * time.Hour)},
+
+		{"de", de.All, "um Mitternacht", tomorrow},
+		{"de", de.All, "Freitag um Mitternacht", saturday},
+		{"de", de.All, "morgen zu Mittag", tomorrow.Add(12 * time.Hour)},
+		{"de", de.All, "12 Uhr nachts", tomorrow},
+
+		{"fr", fr.All, "à minuit", tomorrow},
+		{"fr", fr.All, "vendredi à minuit", saturday},
+		{"fr", fr.All, "demain à midi", tomorrow.Add(12 * time.Hour)},
+		{"fr", fr.All, "12h du matin", today},
+
+		{"es", es.All, "a medianoche", tomorrow},
+		{"es", es.All, "el viernes a medianoche", saturday},
+		{"es", es.All, "mañana al mediodía", tomorrow.Add(12 * time.Hour)},
+		{"es", es.All, "a las 12 de la noche", tomorrow},
+
+		{"ja", ja.All, "真夜中", tomorrow},
+		{"ja", ja.All, "金曜日の真夜中", saturday},
+		{"ja", ja.All, "明日の正午", tomorrow.Add(12 * time.Hour)},
+		{"ja", ja.All, "午前0時", today},
+
+		{"uk", uk.All, "опівночі", tomorrow},
+		{"uk", uk.All, "у п'ятницю опівночі", saturday},
+		{"uk", uk.All, "завтра опівдні", tomorrow.Add(12 * time.Hour)},
+		{"uk", uk.All, "12 ночі", tomorrow},
+
+		{"pl", pl.All, "o północy", tomorrow},
+		{"pl", pl.All, "w piątek o północy", saturday},
+		{"pl", pl.All, "jutro w południe", tomorrow.Add(12 * time.Hour)},
+		{"pl", pl.All, "o dwunastej w nocy", tomorrow},
+
+		{"ar", ar.All, "منتصف الليل", tomorrow},
+		{"ar", ar.All, "الجمعة عند منتصف الليل", saturday},
+		{"ar", ar.All, "غداً منتصف النهار", tomorrow.Add(12 * time.Hour)},
+		{"ar", ar.All, "12 ليلاً", tomorrow},
+	}
+
+	for i, f := range fixt {
+		res, err := newParser(nil, f.all).Parse(f.text, base)
+		require.Nil(t, err, "[%s] err #%d", f.lang, i)
+		require.NotNil(t, res, "[%s] res #%d", f.lang, i)
+		require.Equal(t, f.want, res.Time, "[%s] time #%d %s", f.lang, i, f.text)
+	}
+}
+
+func TestMidnightStartOfDay(t *testing.T) {
+	o := &rules.Options{
+		Distance:     5,
+		MatchByOrder: true,
+		Midnight:     rules.MidnightStartOfDay,
+	}
+
+	fixt := []struct {
+		lang string
+		all  []rules.Rule
+		text string
+		want time.Time
+	}{
+		{"en", en.All, "midnight friday", friday},
+		{"en", en.All, "at midnight tonight", tomorrow},
+		{"en", en.All, "12am", today},
+		{"ru", ru.All, "в пятницу в полночь", friday},
+		{"ru", ru.All, "12 ночи", today},
+		{"nl", nl.All, "vrijdag om middernacht", friday},
+		{"br", br.All, "sexta à meia-noite", friday},
+		{"zh", zh.All, "星期五午夜", friday},
+		{"zh", zh.All, "今晚午夜", tomorrow},
+		{"de", de.All, "Freitag um Mitternacht", friday},
+		{"de", de.All, "heute Nacht um Mitternacht", tomorrow},
+		{"fr", fr.All, "vendredi à minuit", friday},
+		{"es", es.All, "el viernes a medianoche", friday},
+		{"es", es.All, "a las 12 de la noche", today},
+		{"ja", ja.All, "金曜日の真夜中", friday},
+		{"ja", ja.All, "今夜の真夜中", tomorrow},
+		{"uk", uk.All, "у п'ятницю опівночі", friday},
+		{"pl", pl.All, "w piątek o północy", friday},
+		{"pl", pl.All, "o dwunastej w nocy", today},
+	}
+
+	for i, f := range fixt {
+		res, err := newParser(o, f.all).Parse(f.text, base)
+		require.Nil(t, err, "[%s] err #%d", f.lang, i)
+		require.NotNil(t, res, "[%s] res #%d", f.lang, i)
+		require.Equal(t, f.want, res.Time, "[%s] time #%d %s", f.lang, i, f.text)
+	}
+}
+
+func TestDigits(t *testing.T) {
+	fixt := []struct {
+		text   string
+		index  int
+		phrase string
+		want   time.Time
+	}{
+		{"call me in ३ days", 8, "in ३ days", base.AddDate(0, 0, 3)},
+		{"at ５pm", 3, "５pm", today.Add(17 * time.Hour)},
+		{"at １７：３０", 3, "１７：３０", today.Add(17*time.Hour + 30*time.Minute)},
+		{"in ۲ hours", 0, "in ۲ hours", base.Add(2 * time.Hour)},
+		{"in 3 days", 0, "in 3 days", base.AddDate(0, 0, 3)},
+	}
+
+	w := newParser(nil, en.All)
+	w.Normalize(rules.Digits)
+
+	for i, f := range fixt {
+		res, err := w.Parse(f.text, base)
+		require.Nil(t, err, "err #%d", i)
+		require.NotNil(t, res, "res #%d %s", i, f.text)
+		require.Equal(t, f.index, res.Index, "index #%d %s", i, f.text)
+		require.Equal(t, f.phrase, res.Text, "text #%d %s", i, f.text)
+		require.Equal(t, f.want, res.Time, "time #%d %s", i, f.text)
+	}
+
+	res, err := when.AR.Parse("ذكرني بعد ٣ أيام", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, "بعد ٣ أيام", res.Text)
+	require.Equal(t, base.AddDate(0, 0, 3), res.Time)
+}
+
+func TestPositions(t *testing.T) {
+	fixt := []struct {
+		text       string
+		phrase     string
+		start, end when.Position
+	}{
+		{"see you tomorrow", "tomorrow", when.Position{8, 8, 8}, when.Position{16, 16, 16}},
+		{"🙂 see you tomorrow", "tomorrow", when.Position{13, 10, 11}, when.Position{21, 18, 19}},
+		{"ça va, see you tomorrow", "tomorrow", when.Position{16, 15, 15}, when.Position{24, 23, 23}},
+	}
+
+	w := newParser(nil, en.All)
+	for i, f := range fixt {
+		res, err := w.Parse(f.text, base)
+		require.Nil(t, err, "err #%d", i)
+		require.NotNil(t, res, "res #%d %s", i, f.text)
+		require.Equal(t, f.phrase, res.Text, "text #%d %s", i, f.text)
+		require.Equal(t, f.start, res.Start, "start #%d %s", i, f.text)
+		require.Equal(t, f.end, res.End, "end #%d %s", i, f.text)
+		require.Equal(t, res.Start.Byte, res.Index, "index #%d %s", i, f.text)
+	}
+
+	res, err := newParser(nil, ru.All).Parse("🙂 встретимся завтра", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, "завтра", res.Text)
+	require.Equal(t, when.Position{Byte: 26, Rune: 13, UTF16: 14}, res.Start)
+	require.Equal(t, when.Position{Byte: 38, Rune: 19, UTF16: 20}, res.End)
+}
+
+func TestMiddlewareOffsets(t *testing.T) {
+	w := newParser(nil, en.All)
+	w.Use(func(s string) (string, error) {
+		return strings.Replace(s, "tmrw", "tomorrow", -1), nil
+	})
+	w.Normalize(rules.Digits)
+
+	res, err := w.Parse("see you tmrw at ５pm, ok?", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, "see you tmrw at ５pm, ok?", res.Source)
+	require.Equal(t, 8, res.Index)
+	require.Equal(t, "tmrw at ５pm", res.Text)
+	require.Equal(t, when.Position{Byte: 8, Rune: 8, UTF16: 8}, res.Start)
+	require.Equal(t, when.Position{Byte: 21, Rune: 19, UTF16: 19}, res.End)
+	require.Equal(t, tomorrow.Add(17*time.Hour), res.Time)
+}
+
+func TestRuleMeta(t *testing.T) {
+	locales := map[string][]rules.Rule{
+		"en": en.All, "ru": ru.All, "br": br.All, "nl": nl.All, "de": de.All,
+		"fr": fr.All, "es": es.All, "ja": ja.All, "zh": zh.All, "uk": uk.All,
+		"pl": pl.All, "ar": ar.All, "common": common.All,
+	}
+	for name, all := range locales {
+		seen := make(map[string]bool)
+		last := 0
+		for i, r := range all {
+			m := rules.MetaOf(r)
+			require.Equal(t, name, m.Locale, "%s[%d]", name, i)
+			require.True(t, strings.HasPrefix(m.Name, name+"."), m.Name)
+			require.False(t, seen[m.Name], "%s is not unique", m.Name)
+			require.NotEqual(t, rules.Uncategorized, m.Category, m.Name)
+			// the priorities keep the order of the list
+			if i > 0 {
+				require.True(t, m.Priority <= last, m.Name)
+			}
+			seen[m.Name] = true
+			last = m.Priority
+		}
+	}
+}
+
+// tmrw is a rule of no metadata matching "tmrw"
+var tmrw = &rules.F{
+	RegExp: regexp.MustCompile(`(?i)\b(tmrw)\b`),
+	Applier: func(m *rules.Match, c *rules.Context, o *rules.Options, ref time.Time) (bool, error) {
+		c.Duration = 24 * time.Hour
+		c.Hour, c.Minute = pointer.ToInt(9), pointer.ToInt(0)
+		return true, nil
+	},
+	Strategy: rules.Override,
+}
+
+func TestRemove(t *testing.T) {
+	w := newParser(nil, en.All)
+
+	res, err := w.Parse("see you tomorrow", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+
+	require.True(t, w.Remove("en.casual_date"))
+	require.False(t, w.Remove("en.casual_date"))
+	require.False(t, w.Remove("en.unknown"))
+
+	res, err = w.Parse("see you tomorrow", base)
+	require.Nil(t, err)
+	require.Nil(t, res)
+	require.Len(t, w.Rules(), len(en.All)+len(common.All)-1)
+}
+
+func TestReplace(t *testing.T) {
+	w := newParser(nil, en.All)
+
+	require.True(t, w.Replace("en.casual_date", rules.Describe(tmrw, rules.Meta{
+		Name:     "en.tmrw",
+		Locale:   "en",
+		Category: rules.Date,
+		Priority: 60,
+	})))
+	require.False(t, w.Replace("en.casual_date", tmrw))
+
+	res, err := w.Parse("see you tmrw at 5pm", base)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, tomorrow.Add(17*time.Hour), res.Time)
+
+	res, err = w.Parse("see you tomorrow", base)
+	require.Nil(t, err)
+	require.Nil(t, res)
+}
+
+func TestPriority(t *testing.T) {
+	w := when.New(nil)
+	w.Add(tmrw)
+	w.Add(common.All...)
+	w.Add(en.All...)
+
+	list := w.Rules()
+	require.Equal(t, "en.ordinal_weekday_in_month", rules.MetaOf(list[0]).Name)
+	// the rules of the same priority keep the order they were added in
+	require.Equal(t, rules.Rule(tmrw), list[len(list)-3])
+	require.Equal(t, "common.iso_date", rules.MetaOf(list[len(list)-2]).Name)
+	require.Equal(t, "common.slash_dmy", rules.MetaOf(list[len(list)-1]).Name)
+
+	w.Add(rules.Describe(tmrw, rules.Meta{Name: "tmrw", Priority: 100}))
+	require.Equal(t, "tmrw", rules.MetaOf(w.Rules()[0]).Name)
+}
+
+func TestPriorityMixed(t *testing.T) {
+	rule := func() rules.Rule {
+		return &rules.F{RegExp: regexp.MustCompile(`x`), Strategy: rules.Override}
+	}
+	described := func(name string, priority int) rules.Rule {
+		return rules.Describe(rule(), rules.Meta{Name: name, Priority: priority})
+	}
+
+	head, mid, tail := rule(), rule(), rule()
+	low, high, top := described("low", 10), described("high", 50), described("top", 90)
+
+	// the rules without metadata stay after the rules they were added
+	// after, the first one before the first described rule
+	w := when.New(nil)
+	w.Add(head, low, mid, high)
+	require.Equal(t, []rules.Rule{high, head, low, mid}, w.Rules())
+
+	w.Add(tail)
+	w.Add(top)
+	require.Equal(t, []rules.Rule{top, high, head, low, mid, tail}, w.Rules())
+}